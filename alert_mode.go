@@ -0,0 +1,128 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// アラートの発火条件を決めるモードです。
+const (
+	AlertModeAbsolute    = "absolute"     // 現在価格が目標価格以下になったら発火 (従来の挙動)
+	AlertModePercentDrop = "percent_drop" // 直近N日間の平均価格からX%以上下落したら発火
+	AlertModeHistoricLow = "historic_low" // 直近N件の観測のP パーセンタイル以下になったら発火
+)
+
+// AlertModeParams はalert_modeごとに追加で必要なパラメータです。
+// 使われないモードのフィールドはゼロ値のままで構いません。
+type AlertModeParams struct {
+	// PercentDrop・BaselineDaysはpercent_dropモード用です。
+	PercentDrop  float64 `json:"percentDrop,omitempty"`
+	BaselineDays int     `json:"baselineDays,omitempty"`
+
+	// Percentile・LookbackCountはhistoric_lowモード用です。
+	Percentile    float64 `json:"percentile,omitempty"`
+	LookbackCount int     `json:"lookbackCount,omitempty"`
+}
+
+// validateAlertMode はalertModeとそのパラメータが妥当かを検証します。
+func validateAlertMode(alertMode string, params AlertModeParams) error {
+	switch alertMode {
+	case AlertModeAbsolute:
+		return nil
+	case AlertModePercentDrop:
+		if params.PercentDrop <= 0 || params.PercentDrop >= 100 {
+			return fmt.Errorf("percentDropは0より大きく100未満である必要があります")
+		}
+		if params.BaselineDays <= 0 {
+			return fmt.Errorf("baselineDaysは1以上である必要があります")
+		}
+		return nil
+	case AlertModeHistoricLow:
+		if params.Percentile <= 0 || params.Percentile > 100 {
+			return fmt.Errorf("percentileは0より大きく100以下である必要があります")
+		}
+		if params.LookbackCount <= 0 {
+			return fmt.Errorf("lookbackCountは1以上である必要があります")
+		}
+		return nil
+	default:
+		return fmt.Errorf("不明なalertModeです: %s", alertMode)
+	}
+}
+
+// evaluateAlert はalert_modeに応じてアラートが発火すべきかどうかを判定します。
+func evaluateAlert(db *sql.DB, alert Alert, currentPrice int) (bool, error) {
+	switch alert.AlertMode {
+	case AlertModePercentDrop:
+		return evaluatePercentDrop(db, alert, currentPrice)
+	case AlertModeHistoricLow:
+		return evaluateHistoricLow(db, alert, currentPrice)
+	default:
+		return currentPrice <= alert.TargetPrice, nil
+	}
+}
+
+// evaluatePercentDrop は直近BaselineDays日間の平均価格と比較し、PercentDrop%以上
+// 下落していれば発火と判定します。ベースラインとなる観測がまだない場合は発火しません。
+func evaluatePercentDrop(db *sql.DB, alert Alert, currentPrice int) (bool, error) {
+	since := time.Now().AddDate(0, 0, -alert.ModeParams.BaselineDays)
+	prices, err := getPricesSince(db, alert.ID, since)
+	if err != nil {
+		return false, err
+	}
+	if len(prices) == 0 {
+		return false, nil
+	}
+
+	baseline := average(prices)
+	threshold := baseline * (1 - alert.ModeParams.PercentDrop/100)
+
+	return float64(currentPrice) <= threshold, nil
+}
+
+// evaluateHistoricLow は直近LookbackCount件の観測からPercentileパーセンタイル値を求め、
+// 現在価格がそれ以下であれば発火と判定します。観測件数がLookbackCountに満たない場合は発火しません。
+func evaluateHistoricLow(db *sql.DB, alert Alert, currentPrice int) (bool, error) {
+	prices, err := getRecentPrices(db, alert.ID, alert.ModeParams.LookbackCount)
+	if err != nil {
+		return false, err
+	}
+	if len(prices) < alert.ModeParams.LookbackCount {
+		return false, nil
+	}
+
+	threshold := percentile(prices, alert.ModeParams.Percentile)
+
+	return float64(currentPrice) <= threshold, nil
+}
+
+// average はprices の算術平均を返します。
+func average(prices []int) float64 {
+	sum := 0
+	for _, p := range prices {
+		sum += p
+	}
+	return float64(sum) / float64(len(prices))
+}
+
+// percentile はprices の下位p パーセンタイル値を線形補間で求めます(p は0から100)。
+func percentile(prices []int, p float64) float64 {
+	sorted := append([]int(nil), prices...)
+	sort.Ints(sorted)
+
+	if len(sorted) == 1 {
+		return float64(sorted[0])
+	}
+
+	rank := (p / 100) * float64(len(sorted)-1)
+	lower := int(rank)
+	upper := lower + 1
+	if upper >= len(sorted) {
+		return float64(sorted[len(sorted)-1])
+	}
+
+	frac := rank - float64(lower)
+	return float64(sorted[lower])*(1-frac) + float64(sorted[upper])*frac
+}
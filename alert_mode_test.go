@@ -0,0 +1,163 @@
+package main
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestDB はスキーマを適用したインメモリDBを生成します。
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open failed: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	schema, err := os.ReadFile("./database/schema.sql")
+	if err != nil {
+		t.Fatalf("failed to read schema.sql: %v", err)
+	}
+	if _, err := db.Exec(string(schema)); err != nil {
+		t.Fatalf("failed to apply schema: %v", err)
+	}
+
+	return db
+}
+
+// seedPrices はalertIDに紐づくprice_historyをcheckedAtが過去へ遡るtimestampで挿入します。
+// pricesは古い順に並んでいる前提で、最後の要素が最新(現在に最も近い)観測になります。
+func seedPrices(t *testing.T, db *sql.DB, alertID int, prices []int) {
+	t.Helper()
+
+	now := time.Now()
+	for i, price := range prices {
+		checkedAt := now.Add(-time.Duration(len(prices)-i) * time.Hour)
+		if _, err := db.Exec(
+			"INSERT INTO price_history(alert_id, checked_at, price) VALUES(?, ?, ?)",
+			alertID, checkedAt.Format(time.RFC3339), price,
+		); err != nil {
+			t.Fatalf("failed to seed price history: %v", err)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	prices := []int{10000, 8000, 12000, 9000, 11000}
+
+	got := percentile(prices, 0)
+	if got != 8000 {
+		t.Errorf("percentile(prices, 0) = %v, want 8000", got)
+	}
+
+	got = percentile(prices, 100)
+	if got != 12000 {
+		t.Errorf("percentile(prices, 100) = %v, want 12000", got)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	got := average([]int{10000, 20000, 30000})
+	if got != 20000 {
+		t.Errorf("average() = %v, want 20000", got)
+	}
+}
+
+func TestEvaluatePercentDrop_FiresAtExactThreshold(t *testing.T) {
+	db := newTestDB(t)
+
+	prices := make([]int, 10)
+	for i := range prices {
+		prices[i] = 10000
+	}
+	seedPrices(t, db, 1, prices)
+
+	alert := Alert{ID: 1, ModeParams: AlertModeParams{PercentDrop: 30, BaselineDays: 7}}
+
+	// ベースラインは既存の価格履歴(平均10000)のみから計算されるべきで、
+	// これから記録する現在の観測値(7000)を含めてはならない。
+	shouldFire, err := evaluatePercentDrop(db, alert, 7000)
+	if err != nil {
+		t.Fatalf("evaluatePercentDrop failed: %v", err)
+	}
+	if !shouldFire {
+		t.Error("evaluatePercentDrop should fire exactly at the configured threshold (baseline=10000, drop=30%, current=7000)")
+	}
+}
+
+func TestEvaluatePercentDrop_NoBaseline(t *testing.T) {
+	db := newTestDB(t)
+
+	alert := Alert{ID: 1, ModeParams: AlertModeParams{PercentDrop: 30, BaselineDays: 7}}
+
+	shouldFire, err := evaluatePercentDrop(db, alert, 7000)
+	if err != nil {
+		t.Fatalf("evaluatePercentDrop failed: %v", err)
+	}
+	if shouldFire {
+		t.Error("evaluatePercentDrop should not fire when there is no baseline history")
+	}
+}
+
+func TestEvaluateHistoricLow_FiresAtExactThreshold(t *testing.T) {
+	db := newTestDB(t)
+
+	prices := []int{10000, 8000, 12000, 9000, 11000}
+	seedPrices(t, db, 1, prices)
+
+	alert := Alert{ID: 1, ModeParams: AlertModeParams{Percentile: 0, LookbackCount: len(prices)}}
+
+	// percentile(prices, 0) = 8000なので、現在価格がちょうど8000であれば発火するはず。
+	shouldFire, err := evaluateHistoricLow(db, alert, 8000)
+	if err != nil {
+		t.Fatalf("evaluateHistoricLow failed: %v", err)
+	}
+	if !shouldFire {
+		t.Error("evaluateHistoricLow should fire when current price equals the percentile threshold")
+	}
+}
+
+func TestEvaluateHistoricLow_InsufficientHistory(t *testing.T) {
+	db := newTestDB(t)
+
+	seedPrices(t, db, 1, []int{10000, 9000})
+
+	alert := Alert{ID: 1, ModeParams: AlertModeParams{Percentile: 50, LookbackCount: 5}}
+
+	shouldFire, err := evaluateHistoricLow(db, alert, 5000)
+	if err != nil {
+		t.Fatalf("evaluateHistoricLow failed: %v", err)
+	}
+	if shouldFire {
+		t.Error("evaluateHistoricLow should not fire when fewer than lookbackCount observations exist")
+	}
+}
+
+func TestValidateAlertMode(t *testing.T) {
+	if err := validateAlertMode(AlertModeAbsolute, AlertModeParams{}); err != nil {
+		t.Errorf("absolute should always be valid, got %v", err)
+	}
+
+	if err := validateAlertMode(AlertModePercentDrop, AlertModeParams{PercentDrop: 10, BaselineDays: 7}); err != nil {
+		t.Errorf("valid percent_drop params rejected: %v", err)
+	}
+	if err := validateAlertMode(AlertModePercentDrop, AlertModeParams{PercentDrop: 0, BaselineDays: 7}); err == nil {
+		t.Error("percentDrop = 0 should be rejected")
+	}
+
+	if err := validateAlertMode(AlertModeHistoricLow, AlertModeParams{Percentile: 25, LookbackCount: 30}); err != nil {
+		t.Errorf("valid historic_low params rejected: %v", err)
+	}
+	if err := validateAlertMode(AlertModeHistoricLow, AlertModeParams{Percentile: 0, LookbackCount: 30}); err == nil {
+		t.Error("percentile = 0 should be rejected")
+	}
+
+	if err := validateAlertMode("unknown", AlertModeParams{}); err == nil {
+		t.Error("unknown alert mode should be rejected")
+	}
+}
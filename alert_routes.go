@@ -0,0 +1,178 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/httpx"
+)
+
+// handleAlertSubresource は "/api/alerts/{id}" およびその配下のパスをディスパッチします。
+// ログイン中のユーザーが所有するアラートのみを対象とし、他ユーザーのアラートは404として扱います。
+func handleAlertSubresource(w http.ResponseWriter, r *http.Request, db *sql.DB, user *User) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/alerts/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		httpx.WriteError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	alertID, err := strconv.Atoi(segments[0])
+	if err != nil {
+		httpx.WriteErrors(w, http.StatusBadRequest, httpx.Errors{"id": "alert id must be an integer"})
+		return
+	}
+
+	alert, err := getAlertByID(db, alertID)
+	if err != nil {
+		httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("アラートの取得に失敗しました: %v", err))
+		return
+	}
+	if alert == nil || alert.UserID != user.ID {
+		httpx.WriteError(w, http.StatusNotFound, "Alert not found")
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case "DELETE":
+			handleDeleteAlert(w, r, db, *alert)
+		case "PATCH":
+			handleUpdateAlert(w, r, db, *alert)
+		default:
+			httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	if len(segments) != 2 {
+		httpx.WriteError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch segments[1] {
+	case "history":
+		if r.Method != "GET" {
+			httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		handleGetAlertHistory(w, r, db, *alert)
+	case "status":
+		if r.Method != "GET" {
+			httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		handleGetAlertStatus(w, r, *alert)
+	default:
+		httpx.WriteError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+// handleGetAlertStatus はGET /api/alerts/{id}/status のハンドラで、
+// 直近のチェック時刻・エラー内容・次回チェック予定時刻を返します。
+func handleGetAlertStatus(w http.ResponseWriter, r *http.Request, alert Alert) {
+	httpx.WriteJSON(w, http.StatusOK, AlertStatusResponse{
+		Success:             true,
+		AlertID:             alert.ID,
+		LastCheckedAt:       alert.LastCheckedAt,
+		LastError:           alert.LastError,
+		NextCheckAt:         alert.NextCheckAt,
+		ConsecutiveFailures: alert.ConsecutiveFailures,
+	})
+}
+
+// handleDeleteAlert はDELETE /api/alerts/{id} のハンドラで、アラートと関連データを削除します。
+func handleDeleteAlert(w http.ResponseWriter, r *http.Request, db *sql.DB, alert Alert) {
+	if err := deleteAlert(db, alert.ID); err != nil {
+		httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("アラートの削除に失敗しました: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleUpdateAlert はPATCH /api/alerts/{id} のハンドラで、目標価格の変更や
+// 通知を待たずにアラートを無効化/再有効化するために使います。
+func handleUpdateAlert(w http.ResponseWriter, r *http.Request, db *sql.DB, alert Alert) {
+	var req UpdateAlertRequest
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		httpx.WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+
+	if req.TargetPrice != nil {
+		if *req.TargetPrice <= 0 {
+			httpx.WriteErrors(w, http.StatusBadRequest, httpx.Errors{"targetPrice": "targetPrice must be greater than 0"})
+			return
+		}
+		if err := updateAlertTargetPrice(db, alert.ID, *req.TargetPrice); err != nil {
+			httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("目標価格の更新に失敗しました: %v", err))
+			return
+		}
+	}
+
+	if req.IsActive != nil {
+		var updateErr error
+		if *req.IsActive {
+			updateErr = activateAlert(db, alert.ID)
+		} else {
+			updateErr = deactivateAlert(db, alert.ID)
+		}
+		if updateErr != nil {
+			httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("アラート状態の更新に失敗しました: %v", updateErr))
+			return
+		}
+	}
+
+	updated, err := getAlertByID(db, alert.ID)
+	if err != nil || updated == nil {
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to load updated alert")
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, AlertResponse{
+		Success:     true,
+		AlertID:     updated.ID,
+		TargetPrice: updated.TargetPrice,
+		IsActive:    updated.IsActive,
+	})
+}
+
+// handleGetAlertHistory はGET /api/alerts/{id}/history?from=&to= のハンドラで、
+// 指定期間の価格履歴をJSONの時系列として返します。from/toを省略した場合は過去30日分です。
+func handleGetAlertHistory(w http.ResponseWriter, r *http.Request, db *sql.DB, alert Alert) {
+	to := time.Now()
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		parsed, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			httpx.WriteErrors(w, http.StatusBadRequest, httpx.Errors{"to": "to must be an RFC3339 timestamp"})
+			return
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -30)
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		parsed, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			httpx.WriteErrors(w, http.StatusBadRequest, httpx.Errors{"from": "from must be an RFC3339 timestamp"})
+			return
+		}
+		from = parsed
+	}
+
+	points, err := getPriceHistory(db, alert.ID, from, to)
+	if err != nil {
+		httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("価格履歴の取得に失敗しました: %v", err))
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, AlertHistoryResponse{
+		Success: true,
+		AlertID: alert.ID,
+		History: points,
+	})
+}
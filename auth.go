@@ -0,0 +1,224 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/httpx"
+)
+
+// sessionCookieName はログインセッションを保持するクッキーの名前です。
+const sessionCookieName = "hotel_alert_session"
+
+// sessionKeyEnv はセッションクッキーの署名鍵を指定する環境変数です。
+const sessionKeyEnv = "HOTEL_ALERT_SESSION_KEY"
+
+// insecureCookieEnv はTLSなしのローカル開発時にSecure属性を外すための環境変数です。
+// 本番環境では設定しないでください。
+const insecureCookieEnv = "HOTEL_ALERT_INSECURE_COOKIE"
+
+// sessionMaxAge はセッションクッキーの有効期間です。
+const sessionMaxAge = 7 * 24 * 60 * 60 // 7日
+
+var sessionStore = newSessionStore()
+
+// newSessionStore はHOTEL_ALERT_SESSION_KEYから署名鍵を読み込んだCookieStoreを構築します。
+// 未設定の場合はプロセス起動ごとにランダムな鍵を生成します(再起動するとログインセッションが失効します)。
+// クッキーはHttpOnly・SameSite=Laxとし、XSS経由の窃取やCSRF目的の持ち出しを防ぎます。
+// Secure属性はHOTEL_ALERT_INSECURE_COOKIEが設定されている場合のみ外します(TLSなしのローカル開発用)。
+func newSessionStore() *sessions.CookieStore {
+	key := os.Getenv(sessionKeyEnv)
+	var store *sessions.CookieStore
+	if key == "" {
+		log.Printf("警告: %s が未設定のため、起動ごとに変わるセッション鍵を使用します。本番環境では固定値を設定してください。", sessionKeyEnv)
+		store = sessions.NewCookieStore(securecookie.GenerateRandomKey(32))
+	} else {
+		store = sessions.NewCookieStore([]byte(key))
+	}
+
+	store.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   sessionMaxAge,
+		HttpOnly: true,
+		Secure:   os.Getenv(insecureCookieEnv) == "",
+		SameSite: http.SameSiteLaxMode,
+	}
+	return store
+}
+
+// hashPassword はパスワードをbcryptでハッシュ化します。
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("パスワードのハッシュ化に失敗しました: %w", err)
+	}
+	return string(hash), nil
+}
+
+// checkPassword はパスワードがハッシュと一致するか検証します。
+func checkPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// startSession はセッションにuser_idを記録し、クッキーとして書き出します。
+func startSession(w http.ResponseWriter, r *http.Request, userID int) error {
+	session, err := sessionStore.Get(r, sessionCookieName)
+	if err != nil {
+		return fmt.Errorf("セッションの取得に失敗しました: %w", err)
+	}
+	session.Values["user_id"] = userID
+	if err := session.Save(r, w); err != nil {
+		return fmt.Errorf("セッションの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// currentUser はリクエストのセッションクッキーからログイン中のユーザーを解決します。
+// セッションが無い、またはユーザーが存在しない場合はnilを返します。
+func currentUser(r *http.Request, db *sql.DB) (*User, error) {
+	session, _ := sessionStore.Get(r, sessionCookieName)
+
+	userID, ok := session.Values["user_id"].(int)
+	if !ok {
+		return nil, nil
+	}
+
+	return getUserByID(db, userID)
+}
+
+// requireAuth はログイン中のユーザーを解決できたハンドラだけを実行するミドルウェアです。
+func requireAuth(db *sql.DB, next func(w http.ResponseWriter, r *http.Request, db *sql.DB, user *User)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, err := currentUser(r, db)
+		if err != nil {
+			log.Printf("セッションからのユーザー解決に失敗しました: %v", err)
+			http.Error(w, "Failed to resolve session", http.StatusInternalServerError)
+			return
+		}
+		if user == nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r, db, user)
+	}
+}
+
+// handleRegister はPOST /api/register のハンドラで、新規ユーザーを登録しログインセッションを開始します。
+func handleRegister(w http.ResponseWriter, r *http.Request, db *sql.DB) {
+	if r.Method != "POST" {
+		httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req RegisterRequest
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		httpx.WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+
+	if errs := validateRegisterRequest(req); len(errs) > 0 {
+		httpx.WriteErrors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	existing, err := getUserByEmail(db, req.Email)
+	if err != nil {
+		log.Printf("ユーザー検索エラー: %v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to check existing user")
+		return
+	}
+	if existing != nil {
+		httpx.WriteErrors(w, http.StatusConflict, httpx.Errors{"email": "email is already registered"})
+		return
+	}
+
+	passwordHash, err := hashPassword(req.Password)
+	if err != nil {
+		log.Printf("%v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to register user")
+		return
+	}
+
+	user, err := insertUser(db, req.Email, passwordHash)
+	if err != nil {
+		log.Printf("ユーザー挿入エラー: %v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to register user")
+		return
+	}
+
+	if err := startSession(w, r, user.ID); err != nil {
+		log.Printf("%v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to start session")
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, map[string]interface{}{"success": true, "userId": user.ID})
+}
+
+// handleLogin はPOST /api/login のハンドラで、パスワードを検証しログインセッションを開始します。
+func handleLogin(w http.ResponseWriter, r *http.Request, db *sql.DB) {
+	if r.Method != "POST" {
+		httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req LoginRequest
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		httpx.WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
+	}
+
+	if errs := validateLoginRequest(req); len(errs) > 0 {
+		httpx.WriteErrors(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	user, err := getUserByEmail(db, req.Email)
+	if err != nil {
+		log.Printf("ユーザー検索エラー: %v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to get user")
+		return
+	}
+	if user == nil || !checkPassword(user.PasswordHash, req.Password) {
+		httpx.WriteError(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	if err := startSession(w, r, user.ID); err != nil {
+		log.Printf("%v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to start session")
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, map[string]interface{}{"success": true, "userId": user.ID})
+}
+
+// handleLogout はPOST /api/logout のハンドラで、ログインセッションを破棄します。
+func handleLogout(w http.ResponseWriter, r *http.Request, db *sql.DB) {
+	if r.Method != "POST" {
+		httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	session, err := sessionStore.Get(r, sessionCookieName)
+	if err != nil {
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to resolve session")
+		return
+	}
+
+	session.Options.MaxAge = -1
+	if err := session.Save(r, w); err != nil {
+		log.Printf("セッションの破棄に失敗しました: %v", err)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to log out")
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, map[string]interface{}{"success": true})
+}
@@ -0,0 +1,18 @@
+package main
+
+import "testing"
+
+func TestHashAndCheckPassword(t *testing.T) {
+	hash, err := hashPassword("correct-horse-battery-staple")
+	if err != nil {
+		t.Fatalf("hashPassword returned error: %v", err)
+	}
+
+	if !checkPassword(hash, "correct-horse-battery-staple") {
+		t.Error("checkPassword should succeed for the correct password")
+	}
+
+	if checkPassword(hash, "wrong-password") {
+		t.Error("checkPassword should fail for an incorrect password")
+	}
+}
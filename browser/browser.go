@@ -0,0 +1,49 @@
+// Package browser はheadless Chrome(chromedp)を使い、JavaScriptで価格を描画する
+// ページを静的HTML解析の失敗時にレンダリングし直すためのフォールバック経路を提供します。
+package browser
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/chromedp"
+)
+
+// maxConcurrentRenders はヘッドレスChromeを同時に起動する最大数です。
+// 1プロセスあたりの起動コストが高いため、全アダプタで共有するグローバルな上限を設けています。
+const maxConcurrentRenders = 2
+
+var renderSlots = make(chan struct{}, maxConcurrentRenders)
+
+// Render はurlをヘッドレスChromeで開き、selectorが表示されるまで待ってからDOM全体を返します。
+// 同時実行数はmaxConcurrentRendersで制限され、超過した呼び出しは空きが出るまでブロックします。
+func Render(url, selector string, timeout time.Duration) (*goquery.Document, error) {
+	renderSlots <- struct{}{}
+	defer func() { <-renderSlots }()
+
+	ctx, cancel := chromedp.NewContext(context.Background())
+	defer cancel()
+
+	ctx, cancelTimeout := context.WithTimeout(ctx, timeout)
+	defer cancelTimeout()
+
+	var html string
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(url),
+		chromedp.WaitVisible(selector, chromedp.ByQuery),
+		chromedp.OuterHTML("html", &html),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ヘッドレスブラウザでの描画に失敗しました: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return nil, fmt.Errorf("描画後のHTML解析に失敗しました: %w", err)
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+// CreateAlertRequest はPOST /api/alerts のリクエストボディです。
+type CreateAlertRequest struct {
+	HotelURL    string          `json:"hotelUrl"`
+	TargetPrice int             `json:"targetPrice"`
+	Channels    []string        `json:"channels,omitempty"`
+	AlertMode   string          `json:"alertMode,omitempty"`
+	ModeParams  AlertModeParams `json:"modeParams,omitempty"`
+}
+
+// UpdateAlertRequest はPATCH /api/alerts/{id} のリクエストボディです。
+// 指定されたフィールドのみを更新し、省略されたフィールドは変更しません。
+type UpdateAlertRequest struct {
+	TargetPrice *int  `json:"targetPrice,omitempty"`
+	IsActive    *bool `json:"isActive,omitempty"`
+}
+
+// RegisterRequest はPOST /api/register のリクエストボディです。
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// LoginRequest はPOST /api/login のリクエストボディです。
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// AlertResponse はアラートの作成・更新結果を表すレスポンスです。
+type AlertResponse struct {
+	Success     bool `json:"success"`
+	AlertID     int  `json:"alertId"`
+	TargetPrice int  `json:"targetPrice"`
+	IsActive    bool `json:"isActive"`
+}
+
+// AlertSummary はhandleGetAlertsが返すアラート1件分の要約です。
+type AlertSummary struct {
+	ID           int    `json:"id"`
+	Hotel        string `json:"hotel"`
+	CurrentPrice int    `json:"currentPrice"`
+	TargetPrice  int    `json:"targetPrice"`
+	Status       string `json:"status"`
+}
+
+// AlertListResponse はGET /api/alerts のレスポンスです。
+type AlertListResponse struct {
+	Success bool           `json:"success"`
+	Alerts  []AlertSummary `json:"alerts"`
+}
+
+// AlertHistoryResponse はGET /api/alerts/{id}/history のレスポンスです。
+type AlertHistoryResponse struct {
+	Success bool         `json:"success"`
+	AlertID int          `json:"alertId"`
+	History []PricePoint `json:"history"`
+}
+
+// AlertStatusResponse はGET /api/alerts/{id}/status のレスポンスです。
+type AlertStatusResponse struct {
+	Success             bool   `json:"success"`
+	AlertID             int    `json:"alertId"`
+	LastCheckedAt       string `json:"lastCheckedAt"`
+	LastError           string `json:"lastError"`
+	NextCheckAt         string `json:"nextCheckAt"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+}
@@ -0,0 +1,47 @@
+// Package httpx はJSONベースのAPIハンドラ向けの小さなヘルパーで、
+// リクエストのデコードとレスポンス・エラーの共通フォーマットをまとめています。
+package httpx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Errors はフィールド名と検証エラーメッセージの対応です。
+// フィールドに紐づかないエラーは "general" キーに入れます。
+type Errors map[string]string
+
+// errorResponse はエラー時のレスポンスの形です: {"success":false,"errors":{...}}
+type errorResponse struct {
+	Success bool   `json:"success"`
+	Errors  Errors `json:"errors"`
+}
+
+// DecodeJSON はリクエストボディをJSONとしてdstへデコードします。
+// 未知のフィールドがある場合はエラーを返します。
+func DecodeJSON(r *http.Request, dst interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		return fmt.Errorf("リクエストボディの解析に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// WriteJSON はstatusとともにpayloadをJSONレスポンスとして書き込みます。
+func WriteJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// WriteErrors はフィールドごとの検証エラーを {"success":false,"errors":{...}} の形で書き込みます。
+func WriteErrors(w http.ResponseWriter, status int, errs Errors) {
+	WriteJSON(w, status, errorResponse{Success: false, Errors: errs})
+}
+
+// WriteError はフィールドに紐づかない単一のエラーメッセージを書き込みます。
+func WriteError(w http.ResponseWriter, status int, message string) {
+	WriteErrors(w, status, Errors{"general": message})
+}
@@ -0,0 +1,35 @@
+package httpx
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSON_RejectsUnknownFields(t *testing.T) {
+	r := httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"foo","extra":"bar"}`))
+
+	var dst struct {
+		Name string `json:"name"`
+	}
+	if err := DecodeJSON(r, &dst); err == nil {
+		t.Error("DecodeJSON should reject unknown fields")
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	WriteErrors(w, 400, Errors{"email": "email is required"})
+
+	if w.Code != 400 {
+		t.Errorf("status = %d, want 400", w.Code)
+	}
+
+	body := w.Body.String()
+	if !strings.Contains(body, `"success":false`) {
+		t.Errorf("body = %q, want success:false", body)
+	}
+	if !strings.Contains(body, `"email":"email is required"`) {
+		t.Errorf("body = %q, want email error message", body)
+	}
+}
@@ -1,22 +1,74 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
-	"net/smtp"
 	"os"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
 	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/httpx"
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/notifier"
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/scheduler"
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/scraper"
+)
+
+// スケジューラの設定値です。
+const (
+	schedulerWorkers    = 4                // 並行スクレイピングのワーカー数
+	perHostInterval     = 10 * time.Second // 同一ホストへの最小アクセス間隔
+	schedulerQueueSize  = 100              // ジョブキューの容量
+	pollInterval        = 15 * time.Second // 発火期限が来たアラートを探す間隔
+	normalCheckInterval = 1 * time.Minute  // 成功時に次回チェックまで空ける間隔
+	backoffBase         = 1 * time.Minute  // バックオフの基準値
+	backoffCap          = 30 * time.Minute // バックオフの上限
 )
 
+// allowedOriginsEnv はCORSを許可するオリジンをカンマ区切りで指定する環境変数名です。
+// 未設定の場合はローカル開発用のデフォルトのみを許可します。
+const allowedOriginsEnv = "HOTEL_ALERT_ALLOWED_ORIGINS"
+
+// allowedOrigins はCORSで許可するオリジンの一覧を環境変数から読み込みます。
+func allowedOrigins() []string {
+	raw := os.Getenv(allowedOriginsEnv)
+	if raw == "" {
+		return []string{"http://localhost:3000"}
+	}
+
+	var origins []string
+	for _, origin := range strings.Split(raw, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// setAuthCORSHeaders はセッションクッキーを伴うリクエストに必要なCORSヘッダーを設定します。
+// Access-Control-Allow-Credentialsをtrueにする場合、ブラウザはAccess-Control-Allow-Originに
+// ワイルドカードを許さず、リクエスト元のOriginをそのまま返すことを要求するため、
+// 許可リストと照合できたOriginのみをそのまま返します(許可リストはHOTEL_ALERT_ALLOWED_ORIGINSで設定)。
+func setAuthCORSHeaders(w http.ResponseWriter, r *http.Request, allowMethods string) {
+	origin := r.Header.Get("Origin")
+	for _, allowed := range allowedOrigins() {
+		if origin == allowed {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+			break
+		}
+	}
+	w.Header().Set("Vary", "Origin")
+	w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+}
+
 func main() {
 	db, err := initDB()
 	if err != nil {
@@ -24,22 +76,59 @@ func main() {
 	}
 	defer db.Close()
 
-	// バックグラウンドで定期的な価格チェックと通知処理を実行
+	// 通知チャンネルの認証情報を環境変数・設定ファイルから読み込み、Registryを構築
+	notifierCfg, err := notifier.LoadConfig(os.Getenv("HOTEL_ALERT_NOTIFIER_CONFIG"))
+	if err != nil {
+		log.Fatalf("通知設定の読み込みに失敗しました: %v", err)
+	}
+	notifiers := notifier.NewRegistryFromConfig(notifierCfg)
+
+	// スクレイピングジョブを処理するワーカープールを起動
+	sched := scheduler.New(schedulerWorkers, perHostInterval, schedulerQueueSize, func(job scheduler.Job) {
+		processAlertJob(db, notifiers, job)
+	})
+	sched.Start(context.Background())
+
+	// 発火期限(next_check_at)が来たアラートを定期的に見つけてキューへ積む
 	go func() {
-		// 例: 1時間ごとにチェック
-		ticker := time.NewTicker(1 * time.Minute)
+		ticker := time.NewTicker(pollInterval)
 		defer ticker.Stop()
 		for range ticker.C {
-			checkAndNotify(db)
+			enqueueDueAlerts(db, sched)
 		}
 	}()
 
 	// Webサーバーを起動
+
+	// アカウント関連のエンドポイント(未ログインでもアクセス可能)
+	http.HandleFunc("/api/register", func(w http.ResponseWriter, r *http.Request) {
+		setAuthCORSHeaders(w, r, "POST, OPTIONS")
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handleRegister(w, r, db)
+	})
+	http.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		setAuthCORSHeaders(w, r, "POST, OPTIONS")
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handleLogin(w, r, db)
+	})
+	http.HandleFunc("/api/logout", func(w http.ResponseWriter, r *http.Request) {
+		setAuthCORSHeaders(w, r, "POST, OPTIONS")
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handleLogout(w, r, db)
+	})
+
 	http.HandleFunc("/api/alerts", func(w http.ResponseWriter, r *http.Request) {
 		// CORSヘッダーをここで一元管理
-		w.Header().Set("Access-Control-Allow-Origin", "*") // 本番環境では 'http://localhost:3000' のように限定してください
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		setAuthCORSHeaders(w, r, "GET, POST, OPTIONS")
 
 		// プリフライトリクエスト(OPTIONS)に対応
 		if r.Method == "OPTIONS" {
@@ -49,62 +138,148 @@ func main() {
 
 		switch r.Method {
 		case "POST":
-			handleCreateAlert(w, r, db)
+			requireAuth(db, handleCreateAlert)(w, r)
 		case "GET":
-			handleGetAlerts(w, r, db)
+			requireAuth(db, handleGetAlerts)(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
 
+	// /api/alerts/{id}、および /api/alerts/{id}/... のサブリソース(価格履歴など)
+	http.HandleFunc("/api/alerts/", func(w http.ResponseWriter, r *http.Request) {
+		setAuthCORSHeaders(w, r, "GET, DELETE, PATCH, OPTIONS")
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		requireAuth(db, handleAlertSubresource)(w, r)
+	})
+
 	fmt.Println("サーバーがポート8080で起動しました...")
 	// この行が実行されると、プログラムはここでブロックされ、サーバーが実行され続けます
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
 
-// checkAndNotify はデータベースのアラートをチェックし、通知を行います
-func checkAndNotify(db *sql.DB) {
-	alerts, err := getActiveAlerts(db)
+// enqueueDueAlerts はnext_check_atが到来したアクティブなアラートをスケジューラのキューへ積みます。
+func enqueueDueAlerts(db *sql.DB, sched *scheduler.Scheduler) {
+	alerts, err := getDueAlerts(db)
 	if err != nil {
-		log.Printf("アクティブなアラートの取得に失敗しました: %v", err)
+		log.Printf("チェック期限が来たアラートの取得に失敗しました: %v", err)
 		return
 	}
 
-	if len(alerts) == 0 {
-		fmt.Println("チェックするアラートがありません。")
+	for _, alert := range alerts {
+		sched.Enqueue(scheduler.Job{AlertID: alert.ID, HotelURL: alert.HotelURL})
+	}
+}
+
+// processAlertJob は1件のアラートをスクレイピングし、価格履歴の記録・発火判定・通知までを行います。
+// スクレイピングに失敗した場合は連続失敗回数を記録し、指数バックオフで次回チェック時刻を遅らせます。
+func processAlertJob(db *sql.DB, notifiers *notifier.Registry, job scheduler.Job) {
+	alert, err := getAlertByID(db, job.AlertID)
+	if err != nil {
+		log.Printf("アラートの取得に失敗しました (アラートID:%d): %v", job.AlertID, err)
+		return
+	}
+	if alert == nil || !alert.IsActive {
+		return
+	}
+
+	hotelInfo, err := scraper.Scrape(alert.HotelURL)
+	if err != nil {
+		log.Printf("価格のスクレイピングに失敗しました (%s): %v", alert.HotelURL, err)
+		if recErr := recordScrapeFailure(db, *alert, err); recErr != nil {
+			log.Printf("スクレイピング失敗の記録に失敗しました (アラートID:%d): %v", alert.ID, recErr)
+		}
 		return
 	}
 
-	fmt.Printf("%d件のアラートをチェックします...\n", len(alerts))
+	fmt.Printf("ホテル「%s」(ID:%d): 現在の価格 %d円, 目標価格 %d円\n", hotelInfo.Name, alert.ID, hotelInfo.Price, alert.TargetPrice)
 
-	for _, alert := range alerts {
-		hotelInfo, err := scrapeHotelInfo(alert.HotelURL)
+	if err := recordScrapeSuccess(db, alert.ID); err != nil {
+		log.Printf("スクレイピング成功の記録に失敗しました (アラートID:%d): %v", alert.ID, err)
+	}
+
+	// percent_drop/historic_lowは過去の価格履歴からベースラインを計算するため、
+	// 今回の観測値をinsertPriceHistoryで記録する前に評価する
+	// (先に記録すると今回の観測値自身がベースラインに混ざってしまう)
+	shouldFire, evalErr := evaluateAlert(db, *alert, hotelInfo.Price)
+	if evalErr != nil {
+		log.Printf("アラート条件の評価に失敗しました (アラートID:%d): %v", alert.ID, evalErr)
+	}
+
+	if err := insertPriceHistory(db, alert.ID, hotelInfo.Price); err != nil {
+		log.Printf("価格履歴の記録に失敗しました (アラートID:%d): %v", alert.ID, err)
+	}
+
+	if evalErr != nil {
+		return
+	}
+
+	if shouldFire {
+		email, err := getUserEmail(db, alert.UserID)
 		if err != nil {
-			log.Printf("価格のスクレイピングに失敗しました (%s): %v", alert.HotelURL, err)
+			log.Printf("ユーザーのメールアドレス取得に失敗しました: %v", err)
+			return
+		}
+
+		notifyAlert(db, notifiers, *alert, hotelInfo, email)
+
+		// 通知後はアラートを無効化する
+		if err := deactivateAlert(db, alert.ID); err != nil {
+			log.Printf("アラートの無効化に失敗しました: %v", err)
+		}
+	}
+}
+
+// notifyAlert はアラートに設定された全チャンネルへ通知を送信し、結果をnotificationsテーブルへ記録します
+func notifyAlert(db *sql.DB, notifiers *notifier.Registry, alert Alert, hotelInfo *scraper.HotelInfo, email string) {
+	if len(alert.Channels) == 0 {
+		log.Printf("アラートID %d に通知チャンネルが設定されていません", alert.ID)
+		return
+	}
+
+	payload := notifier.Alert{
+		HotelName:    hotelInfo.Name,
+		HotelURL:     alert.HotelURL,
+		CurrentPrice: hotelInfo.Price,
+		TargetPrice:  alert.TargetPrice,
+		UserEmail:    email,
+	}
+
+	for _, channelID := range alert.Channels {
+		n, ok := notifiers.Get(channelID)
+		if !ok {
+			recordNotification(db, alert.ID, channelID, fmt.Errorf("チャンネル '%s' は設定されていません", channelID))
 			continue
 		}
 
-		fmt.Printf("ホテル「%s」(ID:%d): 現在の価格 %d円, 目標価格 %d円\n", hotelInfo.Name, alert.ID, hotelInfo.Price, alert.TargetPrice)
-
-		if hotelInfo.Price <= alert.TargetPrice {
-			email, err := getUserEmail(db, alert.UserID)
-			if err != nil {
-				log.Printf("ユーザーのメールアドレス取得に失敗しました: %v", err)
-				continue
-			}
-
-			err = sendNotification(email, alert.HotelURL, hotelInfo.Price, hotelInfo.Name)
-			if err != nil {
-				log.Printf("通知メールの送信に失敗しました: %v", err)
-				continue
-			}
-
-			// 通知後はアラートを無効化する
-			err = deactivateAlert(db, alert.ID)
-			if err != nil {
-				log.Printf("アラートの無効化に失敗しました: %v", err)
-			}
+		err := n.Send(payload)
+		if err != nil {
+			log.Printf("通知の送信に失敗しました (アラートID:%d, チャンネル:%s): %v", alert.ID, channelID, err)
 		}
+		recordNotification(db, alert.ID, channelID, err)
+	}
+}
+
+// recordNotification は通知の送信結果をnotificationsテーブルへ記録します
+func recordNotification(db *sql.DB, alertID int, channelID string, sendErr error) {
+	success := 1
+	var errMsg sql.NullString
+	if sendErr != nil {
+		success = 0
+		errMsg = sql.NullString{String: sendErr.Error(), Valid: true}
+	}
+
+	_, err := db.Exec(
+		"INSERT INTO notifications(alert_id, channel, success, error_message, sent_at) VALUES(?, ?, ?, ?, ?)",
+		alertID, channelID, success, errMsg, time.Now().Format(time.RFC3339),
+	)
+	if err != nil {
+		log.Printf("通知結果の記録に失敗しました (アラートID:%d, チャンネル:%s): %v", alertID, channelID, err)
 	}
 }
 
@@ -130,30 +305,38 @@ func initDB() (*sql.DB, error) {
 
 // User はユーザー情報を表す構造体です
 type User struct {
-	ID        int
-	Email     string
-	CreatedAt string
+	ID           int
+	Email        string
+	PasswordHash string
+	CreatedAt    string
 }
 
 // Alert はアラート設定を表す構造体です
 type Alert struct {
-	ID          int
-	UserID      int
-	HotelURL    string
-	TargetPrice int
-	IsActive    bool
-	CreatedAt   string
+	ID                  int
+	UserID              int
+	HotelURL            string
+	TargetPrice         int
+	IsActive            bool
+	Channels            []string        // 通知チャンネルID(例: "smtp", "slack")のリスト
+	AlertMode           string          // "absolute", "percent_drop", "historic_low"
+	ModeParams          AlertModeParams // AlertModeごとの追加パラメータ
+	ConsecutiveFailures int             // 直近のスクレイピング連続失敗回数
+	NextCheckAt         string          // 次回チェック予定時刻(RFC3339)
+	LastCheckedAt       string          // 直近のチェック実行時刻(RFC3339)
+	LastError           string          // 直近のスクレイピングエラー内容(成功時は空)
+	CreatedAt           string
 }
 
 // insertUser は新しいユーザーをデータベースに挿入します
-func insertUser(db *sql.DB, email string) (*User, error) {
-	stmt, err := db.Prepare("INSERT INTO users(email, created_at) VALUES(?, ?)")
+func insertUser(db *sql.DB, email, passwordHash string) (*User, error) {
+	stmt, err := db.Prepare("INSERT INTO users(email, password_hash, created_at) VALUES(?, ?, ?)")
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.Exec(email, time.Now().Format(time.RFC3339))
+	res, err := stmt.Exec(email, passwordHash, time.Now().Format(time.RFC3339))
 	if err != nil {
 		return nil, err
 	}
@@ -163,18 +346,28 @@ func insertUser(db *sql.DB, email string) (*User, error) {
 		return nil, err
 	}
 
-	return &User{ID: int(id), Email: email, CreatedAt: time.Now().Format(time.RFC3339)}, nil
+	return &User{ID: int(id), Email: email, PasswordHash: passwordHash, CreatedAt: time.Now().Format(time.RFC3339)}, nil
 }
 
 // insertAlert は新しいアラートをデータベースに挿入します
-func insertAlert(db *sql.DB, userID int, hotelURL string, targetPrice int) (*Alert, error) {
-	stmt, err := db.Prepare("INSERT INTO alerts(user_id, hotel_url, target_price, is_active, created_at) VALUES(?, ?, ?, ?, ?)")
+func insertAlert(db *sql.DB, userID int, hotelURL string, targetPrice int, channels []string, alertMode string, modeParams AlertModeParams) (*Alert, error) {
+	channelsJSON, err := json.Marshal(channels)
+	if err != nil {
+		return nil, fmt.Errorf("通知チャンネルのエンコードに失敗しました: %w", err)
+	}
+
+	modeParamsJSON, err := json.Marshal(modeParams)
+	if err != nil {
+		return nil, fmt.Errorf("アラートモードパラメータのエンコードに失敗しました: %w", err)
+	}
+
+	stmt, err := db.Prepare("INSERT INTO alerts(user_id, hotel_url, target_price, is_active, channels, alert_mode, mode_params, created_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.Exec(userID, hotelURL, targetPrice, 1, time.Now().Format(time.RFC3339))
+	res, err := stmt.Exec(userID, hotelURL, targetPrice, 1, string(channelsJSON), alertMode, string(modeParamsJSON), time.Now().Format(time.RFC3339))
 	if err != nil {
 		return nil, err
 	}
@@ -190,149 +383,87 @@ func insertAlert(db *sql.DB, userID int, hotelURL string, targetPrice int) (*Ale
 		HotelURL:    hotelURL,
 		TargetPrice: targetPrice,
 		IsActive:    true,
+		Channels:    channels,
+		AlertMode:   alertMode,
+		ModeParams:  modeParams,
 		CreatedAt:   time.Now().Format(time.RFC3339),
 	}, nil
 }
 
-// handleCreateAlert はアラート登録APIのエンドポイントです
-func handleCreateAlert(w http.ResponseWriter, r *http.Request, db *sql.DB) {
+// handleCreateAlert はアラート登録APIのエンドポイントです。アラートはログイン中のユーザーに紐づきます。
+func handleCreateAlert(w http.ResponseWriter, r *http.Request, db *sql.DB, user *User) {
 	if r.Method != "POST" {
-		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		httpx.WriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
-	email := r.FormValue("email")
-	hotelURL := r.FormValue("hotelUrl")
-	targetPriceStr := r.FormValue("targetPrice")
-	targetPrice := 0
-	if targetPriceStr != "" {
-		_, err := fmt.Sscan(targetPriceStr, &targetPrice)
-		if err != nil {
-			http.Error(w, "Invalid target price", http.StatusBadRequest)
-			return
-		}
+	var req CreateAlertRequest
+	if err := httpx.DecodeJSON(r, &req); err != nil {
+		httpx.WriteError(w, http.StatusBadRequest, "Invalid JSON body")
+		return
 	}
 
-	// ユーザーの重複をチェック
-	user, err := getUserByEmail(db, email)
-	if err != nil {
-		log.Printf("ユーザー検索エラー: %v", err)
-		http.Error(w, "Failed to get user", http.StatusInternalServerError)
-		return
+	// 通知チャンネルは未指定の場合はSMTPのみ
+	if len(req.Channels) == 0 {
+		req.Channels = []string{"smtp"}
+	}
+	// アラートモードは未指定の場合はabsolute = 従来の挙動
+	if req.AlertMode == "" {
+		req.AlertMode = AlertModeAbsolute
 	}
 
-	if user == nil {
-		// ユーザーが存在しない場合、新しく作成
-		user, err = insertUser(db, email)
-		if err != nil {
-			log.Printf("ユーザー挿入エラー: %v", err)
-			http.Error(w, "Failed to create user", http.StatusInternalServerError)
-			return
-		}
+	if errs := validateCreateAlertRequest(req); len(errs) > 0 {
+		httpx.WriteErrors(w, http.StatusBadRequest, errs)
+		return
 	}
 
-	// 取得したユーザーIDを使ってアラートを挿入
-	_, err = insertAlert(db, user.ID, hotelURL, targetPrice)
+	alert, err := insertAlert(db, user.ID, req.HotelURL, req.TargetPrice, req.Channels, req.AlertMode, req.ModeParams)
 	if err != nil {
 		log.Printf("アラート挿入エラー: %v", err)
-		http.Error(w, "Failed to create alert", http.StatusInternalServerError)
+		httpx.WriteError(w, http.StatusInternalServerError, "Failed to create alert")
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-	fmt.Fprintf(w, "アラートが正常に登録されました。")
+	httpx.WriteJSON(w, http.StatusCreated, AlertResponse{
+		Success:     true,
+		AlertID:     alert.ID,
+		TargetPrice: alert.TargetPrice,
+		IsActive:    alert.IsActive,
+	})
 }
 
-// HotelInfo はスクレイピングで取得したホテルの情報を保持します
-type HotelInfo struct {
-	Price int
-	Name  string
-}
+// alertColumns はAlertの全カラムで、getActiveAlertsForUser/getAlertByID/getDueAlertsで共有します。
+const alertColumns = "id, user_id, hotel_url, target_price, channels, alert_mode, mode_params, consecutive_failures, next_check_at, last_checked_at, last_error"
 
-// scrapeHotelInfo は指定されたホテルの現在の価格と名前をスクレイピングします
-func scrapeHotelInfo(url string) (*HotelInfo, error) {
-	// http.Getではヘッダーをカスタマイズできないため、http.Clientを使用します
-	client := &http.Client{
-		// タイムアウトを設定して、リクエストが長引くのを防ぎます
-		Timeout: 30 * time.Second,
-	}
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
-	}
+// scanAlert はalertColumnsの並びでSELECTした1行をAlertへ読み込みます。
+func scanAlert(scan func(...interface{}) error) (Alert, error) {
+	var alert Alert
+	var channelsJSON, modeParamsJSON string
+	var nextCheckAt, lastCheckedAt, lastError sql.NullString
 
-	// 一般的なブラウザのUser-Agentを設定します
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
-
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("HTTPリクエストに失敗しました: %w", err)
+	if err := scan(
+		&alert.ID, &alert.UserID, &alert.HotelURL, &alert.TargetPrice, &channelsJSON, &alert.AlertMode, &modeParamsJSON,
+		&alert.ConsecutiveFailures, &nextCheckAt, &lastCheckedAt, &lastError,
+	); err != nil {
+		return Alert{}, err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("ステータスコードが異常です: %d %s", res.StatusCode, res.Status)
+	if err := json.Unmarshal([]byte(channelsJSON), &alert.Channels); err != nil {
+		return Alert{}, fmt.Errorf("アラートID %d の通知チャンネルの解析に失敗しました: %w", alert.ID, err)
 	}
-
-	doc, err := goquery.NewDocumentFromReader(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("HTML解析に失敗しました: %w", err)
+	if err := json.Unmarshal([]byte(modeParamsJSON), &alert.ModeParams); err != nil {
+		return Alert{}, fmt.Errorf("アラートID %d のモードパラメータの解析に失敗しました: %w", alert.ID, err)
 	}
+	alert.NextCheckAt = nextCheckAt.String
+	alert.LastCheckedAt = lastCheckedAt.String
+	alert.LastError = lastError.String
 
-	var priceStr string
-	var selector string
-	var name string
-
-	// URLに基づいてサイトを判別し、適切なセレクタを選択
-	if strings.Contains(url, "travel.rakuten.co.jp") {
-		// 楽天トラベルのセレクタ (複数の可能性に対応)
-		// ホテル名 (ホテルトップページ用とプランページ用)
-		name = doc.Find("#htlName").Text()
-		if name == "" {
-			name = doc.Find("h1.head-hotel-name").Text()
-		}
-		name = strings.TrimSpace(name)
-
-		// 価格 (検索結果ページ用とプラン詳細ページ用のセレクタを両方試す)
-		selector = ".price--num, .rm-prc-prc"
-		priceStr = doc.Find(selector).First().Text()
-	} else if strings.Contains(url, "booking.com") {
-		// Booking.comの価格セレクタの例
-		selector = "[data-testid='price-and-discounted-price']"
-		priceStr = doc.Find(selector).First().Text()
-		name = strings.TrimSpace(doc.Find(".d2fee87262.pp-header__title").Text())
-	} else {
-		// フォールバックまたは他のサイト用のセレクタ
-		selector = ".roomType-charge-price" // 元のセレクタ
-		priceStr = doc.Find(selector).First().Text()
-		name = "Unknown Hotel"
-	}
-
-	if priceStr == "" {
-		return nil, fmt.Errorf("価格情報が見つかりませんでした。セレクタ '%s' を確認してください。", selector)
-	}
-
-	// 数字のみを抽出する正規表現
-	re := regexp.MustCompile(`[0-9]+`)
-	digits := re.FindAllString(priceStr, -1)
-	if len(digits) == 0 {
-		return nil, fmt.Errorf("価格文字列から数字を抽出できませんでした: '%s'", priceStr)
-	}
-
-	// 抽出した数字を結合（例: "￥1,234" -> ["1", "234"] -> "1234"）
-	priceStr = strings.Join(digits, "")
-
-	// 文字列を整数に変換
-	price, err := strconv.Atoi(priceStr)
-	if err != nil {
-		return nil, fmt.Errorf("価格のパースに失敗しました: %w (元文字列: '%s')", err, priceStr)
-	}
-
-	return &HotelInfo{Price: price, Name: name}, nil
+	return alert, nil
 }
 
-func getActiveAlerts(db *sql.DB) ([]Alert, error) {
-	rows, err := db.Query("SELECT id, user_id, hotel_url, target_price FROM alerts WHERE is_active = 1")
+// getActiveAlertsForUser は指定したユーザーが所有するアクティブなアラートを返します。
+func getActiveAlertsForUser(db *sql.DB, userID int) ([]Alert, error) {
+	rows, err := db.Query("SELECT "+alertColumns+" FROM alerts WHERE is_active = 1 AND user_id = ?", userID)
 	if err != nil {
 		return nil, err
 	}
@@ -340,8 +471,8 @@ func getActiveAlerts(db *sql.DB) ([]Alert, error) {
 
 	var alerts []Alert
 	for rows.Next() {
-		var alert Alert
-		if err := rows.Scan(&alert.ID, &alert.UserID, &alert.HotelURL, &alert.TargetPrice); err != nil {
+		alert, err := scanAlert(rows.Scan)
+		if err != nil {
 			return nil, err
 		}
 		alerts = append(alerts, alert)
@@ -350,6 +481,21 @@ func getActiveAlerts(db *sql.DB) ([]Alert, error) {
 	return alerts, nil
 }
 
+// getAlertByID はidに一致するアラートを1件取得します。見つからない場合はnilを返します。
+func getAlertByID(db *sql.DB, id int) (*Alert, error) {
+	row := db.QueryRow("SELECT "+alertColumns+" FROM alerts WHERE id = ?", id)
+
+	alert, err := scanAlert(row.Scan)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &alert, nil
+}
+
 // getUserEmail は user_id に紐づくユーザーのメールアドレスを取得します
 func getUserEmail(db *sql.DB, userID int) (string, error) {
 	var email string
@@ -361,7 +507,8 @@ func getUserEmail(db *sql.DB, userID int) (string, error) {
 }
 func getUserByEmail(db *sql.DB, email string) (*User, error) {
 	var user User
-	err := db.QueryRow("SELECT id, email, created_at FROM users WHERE email = ?", email).Scan(&user.ID, &user.Email, &user.CreatedAt)
+	err := db.QueryRow("SELECT id, email, password_hash, created_at FROM users WHERE email = ?", email).
+		Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // ユーザーが存在しない
@@ -371,53 +518,34 @@ func getUserByEmail(db *sql.DB, email string) (*User, error) {
 	return &user, nil
 }
 
-// sendNotification はユーザーに通知メールを送信します
-func sendNotification(email string, hotelURL string, currentPrice int, hotelName string) error {
-	// TODO: あなたのメールアドレスとアプリパスワードを設定してください
-	from := "あなたのメールアドレス"
-	password := "あなたのアプリパスワード"
-	smtpHost := "smtp.gmail.com"
-	smtpPort := "587"
-
-	to := []string{email}
-	subject := fmt.Sprintf("Subject: 【価格アラート】%sの価格が目標を下回りました！\n", hotelName)
-	body := fmt.Sprintf(`
-「%s」の価格が目標価格を下回りました！
-
-現在の価格: %d円
-ホテルURL: %s
-
-今すぐチェックしましょう！
-`, hotelName, currentPrice, hotelURL)
-
-	msg := []byte(subject + "\n" + body)
-
-	auth := smtp.PlainAuth("", from, password, smtpHost)
-	err := smtp.SendMail(smtpHost+":"+smtpPort, auth, from, to, msg)
+// getUserByID はidに一致するユーザーを取得します。見つからない場合はnilを返します。
+func getUserByID(db *sql.DB, id int) (*User, error) {
+	var user User
+	err := db.QueryRow("SELECT id, email, password_hash, created_at FROM users WHERE id = ?", id).
+		Scan(&user.ID, &user.Email, &user.PasswordHash, &user.CreatedAt)
 	if err != nil {
-		return err
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
 	}
-
-	log.Printf("通知メールを送信しました: %s", email)
-	return nil
+	return &user, nil
 }
 
-// handleGetAlerts はアラート一覧を取得するAPIエンドポイントです
-func handleGetAlerts(w http.ResponseWriter, r *http.Request, db *sql.DB) {
-	w.Header().Set("Content-Type", "application/json")
-
-	// アクティブなアラートを取得
-	alerts, err := getActiveAlerts(db)
+// handleGetAlerts はログイン中のユーザーが所有するアラート一覧を取得するAPIエンドポイントです
+func handleGetAlerts(w http.ResponseWriter, r *http.Request, db *sql.DB, user *User) {
+	// ログイン中のユーザーが所有するアクティブなアラートを取得
+	alerts, err := getActiveAlertsForUser(db, user.ID)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("アラートの取得に失敗しました: %v", err), http.StatusInternalServerError)
+		httpx.WriteError(w, http.StatusInternalServerError, fmt.Sprintf("アラートの取得に失敗しました: %v", err))
 		return
 	}
 
 	// フロントエンド用のレスポンス形式に変換
-	var responseAlerts []map[string]interface{}
+	summaries := make([]AlertSummary, 0, len(alerts))
 	for _, alert := range alerts {
 		// 現在の価格をスクレイピング（エラーが発生した場合は0を設定）
-		hotelInfo, err := scrapeHotelInfo(alert.HotelURL)
+		hotelInfo, err := scraper.Scrape(alert.HotelURL)
 		currentPrice := 0
 		hotelName := "ホテル情報の取得に失敗"
 		if err != nil {
@@ -427,22 +555,16 @@ func handleGetAlerts(w http.ResponseWriter, r *http.Request, db *sql.DB) {
 			hotelName = hotelInfo.Name
 		}
 
-		responseAlert := map[string]interface{}{
-			"id":           alert.ID,
-			"hotel":        hotelName,
-			"currentPrice": currentPrice,
-			"targetPrice":  alert.TargetPrice,
-			"status":       "active",
-		}
-		responseAlerts = append(responseAlerts, responseAlert)
-	}
-
-	response := map[string]interface{}{
-		"success": true,
-		"alerts":  responseAlerts,
+		summaries = append(summaries, AlertSummary{
+			ID:           alert.ID,
+			Hotel:        hotelName,
+			CurrentPrice: currentPrice,
+			TargetPrice:  alert.TargetPrice,
+			Status:       "active",
+		})
 	}
 
-	json.NewEncoder(w).Encode(response)
+	httpx.WriteJSON(w, http.StatusOK, AlertListResponse{Success: true, Alerts: summaries})
 }
 
 // deactivateAlert は指定されたアラートを無効化します
@@ -454,3 +576,48 @@ func deactivateAlert(db *sql.DB, alertID int) error {
 	log.Printf("アラートID %d を無効化しました。", alertID)
 	return nil
 }
+
+// activateAlert は指定されたアラートを再度有効化します
+func activateAlert(db *sql.DB, alertID int) error {
+	_, err := db.Exec("UPDATE alerts SET is_active = 1 WHERE id = ?", alertID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// updateAlertTargetPrice は指定されたアラートの目標価格を変更します
+func updateAlertTargetPrice(db *sql.DB, alertID int, targetPrice int) error {
+	_, err := db.Exec("UPDATE alerts SET target_price = ? WHERE id = ?", targetPrice, alertID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// deleteAlert は指定されたアラートと、それに紐づく通知結果・価格履歴を削除します
+func deleteAlert(db *sql.DB, alertID int) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM notifications WHERE alert_id = ?", alertID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM price_history WHERE alert_id = ?", alertID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM alerts WHERE id = ?", alertID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	log.Printf("アラートID %d を削除しました。", alertID)
+	return nil
+}
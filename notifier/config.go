@@ -0,0 +1,144 @@
+package notifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Config は環境変数または設定ファイルから読み込んだ各通知チャンネルの認証情報です。
+type Config struct {
+	SMTPHost     string
+	SMTPPort     string
+	SMTPFrom     string
+	SMTPPassword string
+
+	SlackWebhookURL string
+
+	DiscordWebhookURL string
+
+	LineNotifyToken string
+
+	// Webhooks はチャンネルID(任意の名前)とURLの対応で、汎用Webhook通知に使われます。
+	Webhooks map[string]string
+}
+
+// fileConfig は設定ファイル(JSON)のスキーマです。
+type fileConfig struct {
+	SMTP struct {
+		Host     string `json:"host"`
+		Port     string `json:"port"`
+		From     string `json:"from"`
+		Password string `json:"password"`
+	} `json:"smtp"`
+	Slack struct {
+		WebhookURL string `json:"webhook_url"`
+	} `json:"slack"`
+	Discord struct {
+		WebhookURL string `json:"webhook_url"`
+	} `json:"discord"`
+	Line struct {
+		Token string `json:"token"`
+	} `json:"line"`
+	Webhooks map[string]string `json:"webhooks"`
+}
+
+// LoadConfig は環境変数と、任意で指定した設定ファイル(JSON)から通知設定を読み込みます。
+// configPathが空、またはファイルが存在しない場合は環境変数のみが使われます。
+// 設定ファイルの値は環境変数より優先されます。
+func LoadConfig(configPath string) (*Config, error) {
+	cfg := &Config{
+		SMTPHost:          envOr("HOTEL_ALERT_SMTP_HOST", "smtp.gmail.com"),
+		SMTPPort:          envOr("HOTEL_ALERT_SMTP_PORT", "587"),
+		SMTPFrom:          os.Getenv("HOTEL_ALERT_SMTP_FROM"),
+		SMTPPassword:      os.Getenv("HOTEL_ALERT_SMTP_PASSWORD"),
+		SlackWebhookURL:   os.Getenv("HOTEL_ALERT_SLACK_WEBHOOK_URL"),
+		DiscordWebhookURL: os.Getenv("HOTEL_ALERT_DISCORD_WEBHOOK_URL"),
+		LineNotifyToken:   os.Getenv("HOTEL_ALERT_LINE_TOKEN"),
+		Webhooks:          map[string]string{},
+	}
+
+	if configPath == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(configPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("通知設定ファイルの読み込みエラー: %w", err)
+	}
+
+	var fc fileConfig
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return nil, fmt.Errorf("通知設定ファイルの解析エラー: %w", err)
+	}
+	fc.applyTo(cfg)
+
+	return cfg, nil
+}
+
+func (fc fileConfig) applyTo(cfg *Config) {
+	if fc.SMTP.Host != "" {
+		cfg.SMTPHost = fc.SMTP.Host
+	}
+	if fc.SMTP.Port != "" {
+		cfg.SMTPPort = fc.SMTP.Port
+	}
+	if fc.SMTP.From != "" {
+		cfg.SMTPFrom = fc.SMTP.From
+	}
+	if fc.SMTP.Password != "" {
+		cfg.SMTPPassword = fc.SMTP.Password
+	}
+	if fc.Slack.WebhookURL != "" {
+		cfg.SlackWebhookURL = fc.Slack.WebhookURL
+	}
+	if fc.Discord.WebhookURL != "" {
+		cfg.DiscordWebhookURL = fc.Discord.WebhookURL
+	}
+	if fc.Line.Token != "" {
+		cfg.LineNotifyToken = fc.Line.Token
+	}
+	for id, url := range fc.Webhooks {
+		cfg.Webhooks[id] = url
+	}
+}
+
+// NewRegistryFromConfig はConfigに認証情報が設定されているチャンネルだけをRegistryに登録します。
+// 未設定のチャンネル(トークンやURLが空)は登録されないため、アラート側で選択しても無視されます。
+func NewRegistryFromConfig(cfg *Config) *Registry {
+	reg := NewRegistry()
+
+	if cfg.SMTPFrom != "" && cfg.SMTPPassword != "" {
+		reg.Register("smtp", &SMTPNotifier{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			From:     cfg.SMTPFrom,
+			Password: cfg.SMTPPassword,
+		})
+	}
+	if cfg.SlackWebhookURL != "" {
+		reg.Register("slack", &SlackNotifier{WebhookURL: cfg.SlackWebhookURL})
+	}
+	if cfg.DiscordWebhookURL != "" {
+		reg.Register("discord", &DiscordNotifier{WebhookURL: cfg.DiscordWebhookURL})
+	}
+	if cfg.LineNotifyToken != "" {
+		reg.Register("line", &LineNotifier{Token: cfg.LineNotifyToken})
+	}
+	for id, url := range cfg.Webhooks {
+		reg.Register(id, &WebhookNotifier{URL: url})
+	}
+
+	return reg
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,32 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookClient はWebhook系Notifier(Slack/Discord/汎用Webhook)が共有するHTTPクライアントです。
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// postJSON はpayloadをJSONエンコードしてwebhookURLへPOSTします。
+func postJSON(webhookURL string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("通知ペイロードのエンコードに失敗しました: %w", err)
+	}
+
+	res, err := webhookClient.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Webhook通知の送信に失敗しました: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("Webhook通知がエラーを返しました: %d %s", res.StatusCode, res.Status)
+	}
+
+	return nil
+}
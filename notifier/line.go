@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// lineNotifyEndpoint はLINE NotifyのAPIエンドポイントです。
+const lineNotifyEndpoint = "https://notify-api.line.me/api/notify"
+
+// LineNotifier はLINE Notify経由でアラートを送信するNotifierです。
+type LineNotifier struct {
+	Token string
+}
+
+// Send はLINE Notifyのアクセストークンに紐づくトークへ価格アラートを送信します。
+func (n *LineNotifier) Send(alert Alert) error {
+	if n.Token == "" {
+		return fmt.Errorf("LINE Notify通知のトークンが設定されていません")
+	}
+
+	message := fmt.Sprintf(
+		"\n「%s」の価格が目標を下回りました！\n現在の価格: %d円 (目標: %d円)\n%s",
+		alert.HotelName, alert.CurrentPrice, alert.TargetPrice, alert.HotelURL,
+	)
+
+	form := url.Values{}
+	form.Set("message", message)
+
+	req, err := http.NewRequest(http.MethodPost, lineNotifyEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("LINE Notifyリクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+n.Token)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("LINE Notify通知の送信に失敗しました: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("LINE Notify通知がエラーを返しました: %d %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package notifier はアラート発火時の通知チャンネル(SMTP/Slack/Discord/LINE/汎用Webhook)を
+// 共通のインターフェースで扱うためのパッケージです。
+package notifier
+
+// Alert は通知内容として各Notifierに渡されるアラート情報です。
+type Alert struct {
+	HotelName    string
+	HotelURL     string
+	CurrentPrice int
+	TargetPrice  int
+	UserEmail    string
+}
+
+// Notifier は特定のチャンネルへアラート通知を送信するインターフェースです。
+// 実装はSMTP・Slack・Discord・LINE Notify・汎用Webhookなど多岐にわたります。
+type Notifier interface {
+	// Send はアラート内容を通知します。送信に失敗した場合はエラーを返します。
+	Send(alert Alert) error
+}
+
+// Registry はチャンネルID(例: "smtp", "slack", "webhook:ops")とNotifierの対応を保持します。
+type Registry struct {
+	notifiers map[string]Notifier
+}
+
+// NewRegistry は空のRegistryを生成します。
+func NewRegistry() *Registry {
+	return &Registry{notifiers: make(map[string]Notifier)}
+}
+
+// Register はchannelIDにNotifierを登録します。既に同じIDが登録済みの場合は上書きします。
+func (r *Registry) Register(channelID string, n Notifier) {
+	r.notifiers[channelID] = n
+}
+
+// Get はchannelIDに対応するNotifierを返します。未登録の場合はokがfalseになります。
+func (r *Registry) Get(channelID string) (Notifier, bool) {
+	n, ok := r.notifiers[channelID]
+	return n, ok
+}
+
+// Channels は登録済みの全チャンネルIDを返します。
+func (r *Registry) Channels() []string {
+	channels := make([]string, 0, len(r.notifiers))
+	for id := range r.notifiers {
+		channels = append(channels, id)
+	}
+	return channels
+}
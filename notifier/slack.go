@@ -0,0 +1,24 @@
+package notifier
+
+import "fmt"
+
+// SlackNotifier はSlackのIncoming Webhook経由でアラートを送信するNotifierです。
+type SlackNotifier struct {
+	WebhookURL string
+}
+
+// Send はSlackチャンネルへ価格アラートのメッセージを投稿します。
+func (n *SlackNotifier) Send(alert Alert) error {
+	if n.WebhookURL == "" {
+		return fmt.Errorf("Slack通知のWebhook URLが設定されていません")
+	}
+
+	payload := map[string]string{
+		"text": fmt.Sprintf(
+			"「%s」の価格が目標を下回りました！\n現在の価格: %d円 (目標: %d円)\n%s",
+			alert.HotelName, alert.CurrentPrice, alert.TargetPrice, alert.HotelURL,
+		),
+	}
+
+	return postJSON(n.WebhookURL, payload)
+}
@@ -0,0 +1,42 @@
+package notifier
+
+import (
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPNotifier はメール(SMTP)経由でアラートを送信するNotifierです。
+type SMTPNotifier struct {
+	Host     string
+	Port     string
+	From     string
+	Password string
+}
+
+// Send は対象ユーザーのメールアドレスへ価格アラートのメールを送信します。
+func (n *SMTPNotifier) Send(alert Alert) error {
+	if n.From == "" || n.Password == "" {
+		return fmt.Errorf("SMTP通知の認証情報が設定されていません")
+	}
+	if alert.UserEmail == "" {
+		return fmt.Errorf("通知先のメールアドレスが指定されていません")
+	}
+
+	subject := fmt.Sprintf("Subject: 【価格アラート】%sの価格が目標を下回りました！\n", alert.HotelName)
+	body := fmt.Sprintf(`
+「%s」の価格が目標価格を下回りました！
+
+現在の価格: %d円
+ホテルURL: %s
+
+今すぐチェックしましょう！
+`, alert.HotelName, alert.CurrentPrice, alert.HotelURL)
+	msg := []byte(subject + "\n" + body)
+
+	auth := smtp.PlainAuth("", n.From, n.Password, n.Host)
+	if err := smtp.SendMail(n.Host+":"+n.Port, auth, n.From, []string{alert.UserEmail}, msg); err != nil {
+		return fmt.Errorf("メール通知の送信に失敗しました: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package notifier
+
+import "fmt"
+
+// WebhookNotifier は任意のURLへアラート内容をJSON POSTする汎用Notifierです。
+// Slack/Discord以外の自前サービスやIFTTT/Zapierなどへの連携に使います。
+type WebhookNotifier struct {
+	URL string
+}
+
+// Send はAlertをそのままJSONエンコードしてWebhook URLへ送信します。
+func (n *WebhookNotifier) Send(alert Alert) error {
+	if n.URL == "" {
+		return fmt.Errorf("Webhook通知のURLが設定されていません")
+	}
+	return postJSON(n.URL, alert)
+}
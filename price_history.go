@@ -0,0 +1,98 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PricePoint は価格履歴の1時点分のデータで、チャートAPIのレスポンス要素になります。
+type PricePoint struct {
+	CheckedAt string `json:"checkedAt"`
+	Price     int    `json:"price"`
+}
+
+// insertPriceHistory はチェックサイクルごとに観測した価格をprice_historyへ記録します。
+// アラートが発火したかどうかに関わらず、全てのチェック結果を記録します。
+func insertPriceHistory(db *sql.DB, alertID int, price int) error {
+	_, err := db.Exec(
+		"INSERT INTO price_history(alert_id, checked_at, price) VALUES(?, ?, ?)",
+		alertID, time.Now().Format(time.RFC3339), price,
+	)
+	if err != nil {
+		return fmt.Errorf("価格履歴の挿入に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// getPriceHistory はalertIDに紐づく価格履歴のうち、[from, to]の期間に含まれるものを
+// checked_atの昇順で返します。
+func getPriceHistory(db *sql.DB, alertID int, from, to time.Time) ([]PricePoint, error) {
+	rows, err := db.Query(
+		"SELECT checked_at, price FROM price_history WHERE alert_id = ? AND checked_at >= ? AND checked_at <= ? ORDER BY checked_at ASC",
+		alertID, from.Format(time.RFC3339), to.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("価格履歴の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	points := []PricePoint{}
+	for rows.Next() {
+		var p PricePoint
+		if err := rows.Scan(&p.CheckedAt, &p.Price); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	return points, nil
+}
+
+// getRecentPrices はalertIDに紐づく直近limit件の価格を新しい順に返します。
+// percent_drop・historic_lowモードの評価に使われます。
+func getRecentPrices(db *sql.DB, alertID int, limit int) ([]int, error) {
+	rows, err := db.Query(
+		"SELECT price FROM price_history WHERE alert_id = ? ORDER BY checked_at DESC LIMIT ?",
+		alertID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("直近の価格の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var prices []int
+	for rows.Next() {
+		var price int
+		if err := rows.Scan(&price); err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
+
+// getPricesSince はalertIDに紐づく、since以降の価格を返します。
+// percent_dropモードのベースライン(直近N日間の平均)計算に使われます。
+func getPricesSince(db *sql.DB, alertID int, since time.Time) ([]int, error) {
+	rows, err := db.Query(
+		"SELECT price FROM price_history WHERE alert_id = ? AND checked_at >= ?",
+		alertID, since.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ベースライン計算用の価格取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var prices []int
+	for rows.Next() {
+		var price int
+		if err := rows.Scan(&price); err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
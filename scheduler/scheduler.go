@@ -0,0 +1,140 @@
+// Package scheduler はアラートのスクレイピングジョブをワーカープールで処理し、
+// ホストごとのレート制限をかけるスケジューラを提供します。
+package scheduler
+
+import (
+	"context"
+	"math/rand"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Job は1件のアラートに対するスクレイピングジョブです。
+type Job struct {
+	AlertID  int
+	HotelURL string
+}
+
+// Scheduler はジョブキューをN個のワーカーで並列に処理しつつ、
+// 同一ホストへのアクセス間隔をHostIntervalで制限します。
+type Scheduler struct {
+	workers      int
+	hostInterval time.Duration
+	handler      func(Job)
+	jobs         chan Job
+
+	mu          sync.Mutex
+	lastHostHit map[string]time.Time
+	hostLocks   map[string]*sync.Mutex
+}
+
+// New はworkers個のワーカーを持つSchedulerを生成します。
+// hostIntervalは同一ホストへの最小アクセス間隔、queueSizeはジョブキューの容量です。
+// handlerは各ジョブを処理するコールバックで、複数ワーカーから並行に呼び出されるため
+// handler自体がスレッドセーフである必要があります。
+func New(workers int, hostInterval time.Duration, queueSize int, handler func(Job)) *Scheduler {
+	return &Scheduler{
+		workers:      workers,
+		hostInterval: hostInterval,
+		handler:      handler,
+		jobs:         make(chan Job, queueSize),
+		lastHostHit:  make(map[string]time.Time),
+		hostLocks:    make(map[string]*sync.Mutex),
+	}
+}
+
+// Start はワーカーを起動します。ctxがキャンセルされるとワーカーは順次停止します。
+func (s *Scheduler) Start(ctx context.Context) {
+	for i := 0; i < s.workers; i++ {
+		go s.worker(ctx)
+	}
+}
+
+// Enqueue はジョブをキューへ積みます。キューが満杯の場合は空きが出るまでブロックします。
+func (s *Scheduler) Enqueue(job Job) {
+	s.jobs <- job
+}
+
+func (s *Scheduler) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-s.jobs:
+			s.waitForHost(ctx, job.HotelURL)
+			s.handler(job)
+		}
+	}
+}
+
+// waitForHost はjob.HotelURLのホストへの前回アクセスからHostIntervalが経過するまで待機します。
+// ホストごとのロックで読み取り→待機→記録を一連のクリティカルセクションとして扱うことで、
+// 同一ホスト宛のジョブが複数ワーカーで同時に処理されても間隔が守られるようにします。
+func (s *Scheduler) waitForHost(ctx context.Context, rawURL string) {
+	host := hostOf(rawURL)
+
+	lock := s.hostLock(host)
+	lock.Lock()
+	defer lock.Unlock()
+
+	s.mu.Lock()
+	last, ok := s.lastHostHit[host]
+	s.mu.Unlock()
+
+	if ok {
+		if wait := s.hostInterval - time.Since(last); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.lastHostHit[host] = time.Now()
+	s.mu.Unlock()
+}
+
+// hostLock はhostに対応する専用ミューテックスを返し、まだ無ければ作成します。
+func (s *Scheduler) hostLock(host string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock, ok := s.hostLocks[host]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.hostLocks[host] = lock
+	}
+	return lock
+}
+
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	return u.Host
+}
+
+// Backoff は連続失敗回数failuresに基づく指数バックオフの待機時間を、
+// 0.5〜1.0倍のジッターを加えて計算します: delay = min(cap, base * 2^failures) * (0.5 + rand)。
+// failuresが0以下の場合は0を返します。
+func Backoff(failures int, base, cap time.Duration) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	delay := base
+	for i := 0; i < failures; i++ {
+		delay *= 2
+		if delay > cap {
+			delay = cap
+			break
+		}
+	}
+
+	jitter := 0.5 + rand.Float64()*0.5
+	return time.Duration(float64(delay) * jitter)
+}
@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBackoff_NoFailures(t *testing.T) {
+	if d := Backoff(0, 1, 100); d != 0 {
+		t.Errorf("Backoff(0, ...) = %v, want 0", d)
+	}
+}
+
+func TestBackoff_RespectsCap(t *testing.T) {
+	const base = 1
+	const capDelay = 10
+
+	for failures := 1; failures <= 20; failures++ {
+		d := Backoff(failures, base, capDelay)
+		if d > capDelay {
+			t.Errorf("Backoff(%d, %d, %d) = %v, exceeds cap", failures, base, capDelay, d)
+		}
+	}
+}
+
+func TestBackoff_GrowsWithFailures(t *testing.T) {
+	// ジッターがあるため、上限(ジッター無し換算)同士を比較する
+	const base = 1000
+	const capDelay = 1_000_000
+
+	small := Backoff(1, base, capDelay)
+	large := Backoff(5, base, capDelay)
+
+	// ジッターは最大でも1倍なので、十分に差があるfailures同士なら growth が見える
+	if large < small {
+		t.Errorf("Backoff did not grow with more failures: Backoff(1)=%v Backoff(5)=%v", small, large)
+	}
+}
+
+// TestScheduler_EnforcesPerHostIntervalUnderConcurrency は、同一ホスト宛のジョブを
+// 複数ワーカーから同時に処理させても、hostIntervalが守られることを確認します。
+func TestScheduler_EnforcesPerHostIntervalUnderConcurrency(t *testing.T) {
+	const hostInterval = 200 * time.Millisecond
+	const jobCount = 4
+
+	var mu sync.Mutex
+	var hits []time.Time
+	done := make(chan struct{}, jobCount)
+
+	sched := New(jobCount, hostInterval, jobCount, func(job Job) {
+		mu.Lock()
+		hits = append(hits, time.Now())
+		mu.Unlock()
+		done <- struct{}{}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sched.Start(ctx)
+
+	for i := 0; i < jobCount; i++ {
+		sched.Enqueue(Job{AlertID: i, HotelURL: "https://travel.rakuten.co.jp/HOTEL/1/"})
+	}
+
+	for i := 0; i < jobCount; i++ {
+		<-done
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 1; i < len(hits); i++ {
+		gap := hits[i].Sub(hits[i-1])
+		if gap < hostInterval/2 {
+			t.Errorf("consecutive hits %v apart, want at least ~%v (per-host interval not enforced)", gap, hostInterval)
+		}
+	}
+}
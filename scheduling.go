@@ -0,0 +1,64 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/scheduler"
+)
+
+// getDueAlerts はアクティブで、かつnext_check_atが未設定または現在時刻以前の
+// (= チェック期限が来た)アラートを返します。
+func getDueAlerts(db *sql.DB) ([]Alert, error) {
+	rows, err := db.Query(
+		"SELECT "+alertColumns+" FROM alerts WHERE is_active = 1 AND (next_check_at IS NULL OR next_check_at <= ?)",
+		time.Now().Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []Alert
+	for rows.Next() {
+		alert, err := scanAlert(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+// recordScrapeSuccess はスクレイピング成功時に連続失敗回数をリセットし、
+// 次回チェック時刻を通常の間隔(normalCheckInterval)先に設定します。
+func recordScrapeSuccess(db *sql.DB, alertID int) error {
+	now := time.Now()
+	_, err := db.Exec(
+		"UPDATE alerts SET consecutive_failures = 0, last_checked_at = ?, last_error = NULL, next_check_at = ? WHERE id = ?",
+		now.Format(time.RFC3339), now.Add(normalCheckInterval).Format(time.RFC3339), alertID,
+	)
+	if err != nil {
+		return fmt.Errorf("スクレイピング成功状態の更新に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// recordScrapeFailure はスクレイピング失敗時に連続失敗回数を増やし、
+// scheduler.Backoffで計算した指数バックオフ(ジッター付き)だけ次回チェック時刻を遅らせます。
+func recordScrapeFailure(db *sql.DB, alert Alert, scrapeErr error) error {
+	now := time.Now()
+	failures := alert.ConsecutiveFailures + 1
+	delay := scheduler.Backoff(failures, backoffBase, backoffCap)
+
+	_, err := db.Exec(
+		"UPDATE alerts SET consecutive_failures = ?, last_checked_at = ?, last_error = ?, next_check_at = ? WHERE id = ?",
+		failures, now.Format(time.RFC3339), scrapeErr.Error(), now.Add(delay).Format(time.RFC3339), alert.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("スクレイピング失敗状態の更新に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Package scraper はOTAサイトから現在の価格とホテル名を取得する処理をまとめたパッケージです。
+// サイトごとの抽出ロジックはsiteパッケージのSiteAdapterに委譲し、
+// JavaScript描画が必要なサイトではbrowserパッケージのヘッドレスレンダリングにフォールバックします。
+package scraper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/browser"
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/site"
+)
+
+// headlessRenderTimeout はヘッドレスブラウザへのフォールバック時の描画タイムアウトです。
+const headlessRenderTimeout = 30 * time.Second
+
+// HotelInfo はスクレイピングで取得したホテルの現在価格と名前です。
+type HotelInfo struct {
+	Price int
+	Name  string
+}
+
+// registry はURLに応じたSiteAdapterの選択に使う既定のレジストリです。
+var registry = site.NewDefaultRegistry()
+
+// Scrape は指定されたホテルの現在の価格と名前を取得します。
+// まず静的HTMLをSiteAdapterで解析し、RequiresJSなアダプタで価格が見つからなかった場合は
+// ヘッドレスブラウザで再描画してから再度抽出を試みます。
+func Scrape(url string) (*HotelInfo, error) {
+	adapter := registry.Match(url)
+	if adapter == nil {
+		return nil, fmt.Errorf("対応していないサイトです: %s", url)
+	}
+
+	doc, err := fetchStaticDocument(url)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := adapter.Extract(doc)
+	if errors.Is(err, site.ErrPriceNotFound) && adapter.RequiresJS() {
+		renderedDoc, renderErr := browser.Render(url, adapter.WaitSelector(), headlessRenderTimeout)
+		if renderErr != nil {
+			return nil, fmt.Errorf("価格情報の取得に失敗しました: %w", renderErr)
+		}
+		info, err = adapter.Extract(renderedDoc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("価格情報の抽出に失敗しました: %w", err)
+	}
+
+	return &HotelInfo{Price: info.Price, Name: info.Name}, nil
+}
+
+// fetchStaticDocument はurlをGETし、レスポンスボディをgoquery.Documentとして解析します。
+func fetchStaticDocument(url string) (*goquery.Document, error) {
+	// http.Getではヘッダーをカスタマイズできないため、http.Clientを使用します
+	client := &http.Client{
+		// タイムアウトを設定して、リクエストが長引くのを防ぎます
+		Timeout: 30 * time.Second,
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+	}
+
+	// 一般的なブラウザのUser-Agentを設定します
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTPリクエストに失敗しました: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("ステータスコードが異常です: %d %s", res.StatusCode, res.Status)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("HTML解析に失敗しました: %w", err)
+	}
+
+	return doc, nil
+}
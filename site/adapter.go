@@ -0,0 +1,38 @@
+// Package site はOTAサイトごとのホテル情報抽出ロジックをSiteAdapterとして切り出し、
+// URLに応じて適切なアダプタを選択するレジストリを提供します。
+package site
+
+import (
+	"errors"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ErrPriceNotFound はセレクタに一致する価格情報がHTML内に見つからなかったことを表します。
+// 静的HTMLの解析でこのエラーが返った場合、RequiresJS()がtrueのアダプタは
+// ヘッドレスブラウザでの再取得にフォールバックします。
+var ErrPriceNotFound = errors.New("価格情報が見つかりませんでした")
+
+// HotelInfo はアダプタが抽出したホテルの現在価格と名前です。
+type HotelInfo struct {
+	Price int
+	Name  string
+}
+
+// Adapter は特定のOTAサイトのHTMLからホテル情報を抽出するインターフェースです。
+type Adapter interface {
+	// Matches はurlがこのアダプタの対象サイトかどうかを判定します。
+	Matches(url string) bool
+
+	// Extract はdocからホテル情報を抽出します。
+	// 価格セレクタが見つからない場合はErrPriceNotFoundを返します。
+	Extract(doc *goquery.Document) (HotelInfo, error)
+
+	// RequiresJS はこのサイトが価格をJavaScriptで描画するため、
+	// 静的HTMLの解析に失敗した場合にヘッドレスブラウザでの再取得が必要かどうかを示します。
+	RequiresJS() bool
+
+	// WaitSelector はヘッドレスブラウザで描画する際に、
+	// 価格が描画されたとみなして良いセレクタを返します。
+	WaitSelector() string
+}
@@ -0,0 +1,34 @@
+package site
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// AgodaAdapter はAgodaのホテルページからホテル情報を抽出します。
+type AgodaAdapter struct{}
+
+// Matches はurlがAgodaのものかどうかを判定します。
+func (AgodaAdapter) Matches(url string) bool {
+	return strings.Contains(url, "agoda.com")
+}
+
+// RequiresJS はAgodaが価格をJavaScriptで描画するためtrueを返します。
+func (AgodaAdapter) RequiresJS() bool { return true }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (AgodaAdapter) WaitSelector() string { return "[data-selenium='display-price']" }
+
+// Extract はAgodaの価格・ホテル名セレクタから情報を抽出します。
+func (AgodaAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	priceStr := doc.Find("[data-selenium='display-price']").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	name := strings.TrimSpace(doc.Find("[data-selenium='hotel-header-name']").Text())
+
+	return HotelInfo{Price: price, Name: name}, nil
+}
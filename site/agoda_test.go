@@ -0,0 +1,29 @@
+package site
+
+import "testing"
+
+func TestAgodaAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/agoda.html")
+
+	info, err := AgodaAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 15600 {
+		t.Errorf("Price = %d, want 15600", info.Price)
+	}
+	if info.Name != "Agoda Ocean View Hotel" {
+		t.Errorf("Name = %q, want %q", info.Name, "Agoda Ocean View Hotel")
+	}
+}
+
+func TestAgodaAdapter_Matches(t *testing.T) {
+	a := AgodaAdapter{}
+	if !a.Matches("https://www.agoda.com/example-hotel/hotel/tokyo-jp.html") {
+		t.Error("AgodaのURLに合致しませんでした")
+	}
+	if a.Matches("https://travel.rakuten.co.jp/HOTEL/12345/") {
+		t.Error("他サイトのURLに誤って合致しました")
+	}
+}
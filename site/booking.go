@@ -0,0 +1,38 @@
+package site
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// BookingAdapter はBooking.comのホテルページからホテル情報を抽出します。
+type BookingAdapter struct{}
+
+// Matches はurlがBooking.comのものかどうかを判定します。
+func (BookingAdapter) Matches(url string) bool {
+	return strings.Contains(url, "booking.com")
+}
+
+// RequiresJS はBooking.comが価格をJavaScriptで描画するためtrueを返します。
+// 静的HTMLをgoqueryで解析しても価格要素が空になることがあるため、
+// その場合はヘッドレスブラウザでの再取得にフォールバックします。
+func (BookingAdapter) RequiresJS() bool { return true }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (BookingAdapter) WaitSelector() string {
+	return "[data-testid='price-and-discounted-price']"
+}
+
+// Extract はBooking.comの価格・ホテル名セレクタから情報を抽出します。
+func (BookingAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	priceStr := doc.Find("[data-testid='price-and-discounted-price']").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	name := strings.TrimSpace(doc.Find(".d2fee87262.pp-header__title").Text())
+
+	return HotelInfo{Price: price, Name: name}, nil
+}
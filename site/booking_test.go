@@ -0,0 +1,34 @@
+package site
+
+import "testing"
+
+func TestBookingAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/booking.html")
+
+	info, err := BookingAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 23400 {
+		t.Errorf("Price = %d, want 23400", info.Price)
+	}
+	if info.Name != "Booking Grand Hotel" {
+		t.Errorf("Name = %q, want %q", info.Name, "Booking Grand Hotel")
+	}
+}
+
+func TestBookingAdapter_Extract_PriceNotYetRendered(t *testing.T) {
+	doc := loadFixture(t, "testdata/booking_js_pending.html")
+
+	_, err := BookingAdapter{}.Extract(doc)
+	if err != ErrPriceNotFound {
+		t.Errorf("err = %v, want ErrPriceNotFound", err)
+	}
+}
+
+func TestBookingAdapter_RequiresJS(t *testing.T) {
+	if !(BookingAdapter{}).RequiresJS() {
+		t.Error("BookingAdapterはRequiresJS() == trueであるべきです")
+	}
+}
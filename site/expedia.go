@@ -0,0 +1,36 @@
+package site
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ExpediaAdapter はExpediaのホテルページからホテル情報を抽出します。
+type ExpediaAdapter struct{}
+
+// Matches はurlがExpediaのものかどうかを判定します。
+func (ExpediaAdapter) Matches(url string) bool {
+	return strings.Contains(url, "expedia.co.jp") || strings.Contains(url, "expedia.com")
+}
+
+// RequiresJS はExpediaが価格をJavaScriptで描画するためtrueを返します。
+func (ExpediaAdapter) RequiresJS() bool { return true }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (ExpediaAdapter) WaitSelector() string {
+	return "[data-stid='price-summary-display-price']"
+}
+
+// Extract はExpediaの価格・ホテル名セレクタから情報を抽出します。
+func (ExpediaAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	priceStr := doc.Find("[data-stid='price-summary-display-price']").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	name := strings.TrimSpace(doc.Find("[data-stid='content-hotel-title']").Text())
+
+	return HotelInfo{Price: price, Name: name}, nil
+}
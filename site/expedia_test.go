@@ -0,0 +1,32 @@
+package site
+
+import "testing"
+
+func TestExpediaAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/expedia.html")
+
+	info, err := ExpediaAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 18200 {
+		t.Errorf("Price = %d, want 18200", info.Price)
+	}
+	if info.Name != "Expedia Tokyo Hotel" {
+		t.Errorf("Name = %q, want %q", info.Name, "Expedia Tokyo Hotel")
+	}
+}
+
+func TestExpediaAdapter_Matches(t *testing.T) {
+	a := ExpediaAdapter{}
+	if !a.Matches("https://www.expedia.co.jp/Tokyo-Hotels.h12345.Hotel-Information") {
+		t.Error("Expedia(.co.jp)のURLに合致しませんでした")
+	}
+	if !a.Matches("https://www.expedia.com/Tokyo-Hotels.h12345.Hotel-Information") {
+		t.Error("Expedia(.com)のURLに合致しませんでした")
+	}
+	if a.Matches("https://www.jalan.net/yad12345/") {
+		t.Error("他サイトのURLに誤って合致しました")
+	}
+}
@@ -0,0 +1,27 @@
+package site
+
+import "github.com/PuerkitoBio/goquery"
+
+// FallbackAdapter は未対応サイト向けの最終手段のアダプタです。
+// どのURLにも合致し、元のセレクタ(.roomType-charge-price)のみを試します。
+type FallbackAdapter struct{}
+
+// Matches は常にtrueを返し、他のどのアダプタにも合致しなかったURLを受け持ちます。
+func (FallbackAdapter) Matches(url string) bool { return true }
+
+// RequiresJS は未知のサイトに対してヘッドレス再取得をむやみに行わないためfalseを返します。
+func (FallbackAdapter) RequiresJS() bool { return false }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (FallbackAdapter) WaitSelector() string { return ".roomType-charge-price" }
+
+// Extract は元のセレクタから価格を抽出します。ホテル名は取得できないため固定値を返します。
+func (FallbackAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	priceStr := doc.Find(".roomType-charge-price").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	return HotelInfo{Price: price, Name: "Unknown Hotel"}, nil
+}
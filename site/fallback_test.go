@@ -0,0 +1,25 @@
+package site
+
+import "testing"
+
+func TestFallbackAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/fallback.html")
+
+	info, err := FallbackAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 7500 {
+		t.Errorf("Price = %d, want 7500", info.Price)
+	}
+	if info.Name != "Unknown Hotel" {
+		t.Errorf("Name = %q, want %q", info.Name, "Unknown Hotel")
+	}
+}
+
+func TestFallbackAdapter_Matches(t *testing.T) {
+	if !(FallbackAdapter{}).Matches("https://example.com/not-an-ota") {
+		t.Error("FallbackAdapterは未知のURLにも合致するべきです")
+	}
+}
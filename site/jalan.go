@@ -0,0 +1,34 @@
+package site
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// JalanAdapter はじゃらんnetのホテルページからホテル情報を抽出します。
+type JalanAdapter struct{}
+
+// Matches はurlがじゃらんnetのものかどうかを判定します。
+func (JalanAdapter) Matches(url string) bool {
+	return strings.Contains(url, "jalan.net")
+}
+
+// RequiresJS はじゃらんnetが価格をサーバーサイドで描画するためfalseを返します。
+func (JalanAdapter) RequiresJS() bool { return false }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (JalanAdapter) WaitSelector() string { return ".yad_price" }
+
+// Extract はじゃらんnetの価格・ホテル名セレクタから情報を抽出します。
+func (JalanAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	priceStr := doc.Find(".yad_price").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	name := strings.TrimSpace(doc.Find(".yadName").Text())
+
+	return HotelInfo{Price: price, Name: name}, nil
+}
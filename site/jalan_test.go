@@ -0,0 +1,29 @@
+package site
+
+import "testing"
+
+func TestJalanAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/jalan.html")
+
+	info, err := JalanAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 9800 {
+		t.Errorf("Price = %d, want 9800", info.Price)
+	}
+	if info.Name != "じゃらん温泉旅館" {
+		t.Errorf("Name = %q, want %q", info.Name, "じゃらん温泉旅館")
+	}
+}
+
+func TestJalanAdapter_Matches(t *testing.T) {
+	a := JalanAdapter{}
+	if !a.Matches("https://www.jalan.net/yad12345/") {
+		t.Error("じゃらんnetのURLに合致しませんでした")
+	}
+	if a.Matches("https://www.booking.com/hotel/jp/example.html") {
+		t.Error("他サイトのURLに誤って合致しました")
+	}
+}
@@ -0,0 +1,30 @@
+package site
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var digitsRe = regexp.MustCompile(`[0-9]+`)
+
+// parsePrice は "￥1,234" のような価格文字列から数字のみを抽出して整数に変換します。
+// rawが空、または数字を含まない場合はErrPriceNotFoundを返します。
+func parsePrice(raw string) (int, error) {
+	if raw == "" {
+		return 0, ErrPriceNotFound
+	}
+
+	digits := digitsRe.FindAllString(raw, -1)
+	if len(digits) == 0 {
+		return 0, ErrPriceNotFound
+	}
+
+	price, err := strconv.Atoi(strings.Join(digits, ""))
+	if err != nil {
+		return 0, fmt.Errorf("価格のパースに失敗しました: %w (元文字列: '%s')", err, raw)
+	}
+
+	return price, nil
+}
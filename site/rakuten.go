@@ -0,0 +1,39 @@
+package site
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// RakutenAdapter は楽天トラベルのホテルページからホテル情報を抽出します。
+type RakutenAdapter struct{}
+
+// Matches はurlが楽天トラベルのものかどうかを判定します。
+func (RakutenAdapter) Matches(url string) bool {
+	return strings.Contains(url, "travel.rakuten.co.jp")
+}
+
+// RequiresJS は楽天トラベルが価格をサーバーサイドで描画するためfalseを返します。
+func (RakutenAdapter) RequiresJS() bool { return false }
+
+// WaitSelector はヘッドレス描画時に待機するセレクタです。
+func (RakutenAdapter) WaitSelector() string { return ".price--num" }
+
+// Extract はホテル名(ホテルトップページ用とプランページ用)と価格
+// (検索結果ページ用とプラン詳細ページ用)を複数のセレクタ候補から抽出します。
+func (RakutenAdapter) Extract(doc *goquery.Document) (HotelInfo, error) {
+	name := doc.Find("#htlName").Text()
+	if name == "" {
+		name = doc.Find("h1.head-hotel-name").Text()
+	}
+	name = strings.TrimSpace(name)
+
+	priceStr := doc.Find(".price--num, .rm-prc-prc").First().Text()
+	price, err := parsePrice(priceStr)
+	if err != nil {
+		return HotelInfo{}, err
+	}
+
+	return HotelInfo{Price: price, Name: name}, nil
+}
@@ -0,0 +1,50 @@
+package site
+
+import (
+	"os"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func loadFixture(t *testing.T, path string) *goquery.Document {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("フィクスチャを開けませんでした: %v", err)
+	}
+	defer f.Close()
+
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatalf("フィクスチャの解析に失敗しました: %v", err)
+	}
+	return doc
+}
+
+func TestRakutenAdapter_Extract(t *testing.T) {
+	doc := loadFixture(t, "testdata/rakuten.html")
+
+	info, err := RakutenAdapter{}.Extract(doc)
+	if err != nil {
+		t.Fatalf("Extractがエラーを返しました: %v", err)
+	}
+
+	if info.Price != 12800 {
+		t.Errorf("Price = %d, want 12800", info.Price)
+	}
+	if info.Name != "楽天リゾートホテル" {
+		t.Errorf("Name = %q, want %q", info.Name, "楽天リゾートホテル")
+	}
+}
+
+func TestRakutenAdapter_Matches(t *testing.T) {
+	a := RakutenAdapter{}
+	if !a.Matches("https://travel.rakuten.co.jp/HOTEL/12345/") {
+		t.Error("楽天トラベルのURLに合致しませんでした")
+	}
+	if a.Matches("https://www.booking.com/hotel/jp/example.html") {
+		t.Error("他サイトのURLに誤って合致しました")
+	}
+}
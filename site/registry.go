@@ -0,0 +1,35 @@
+package site
+
+// Registry はURLに合致するSiteAdapterを選択します。
+type Registry struct {
+	adapters []Adapter
+}
+
+// NewDefaultRegistry は標準搭載のOTAアダプタ一式と、
+// どのURLにも合致するフォールバックアダプタを登録済みのRegistryを返します。
+// フォールバックは必ず最後に評価されるため、Registerで追加する場合も最後に登録してください。
+func NewDefaultRegistry() *Registry {
+	r := &Registry{}
+	r.Register(RakutenAdapter{})
+	r.Register(BookingAdapter{})
+	r.Register(AgodaAdapter{})
+	r.Register(ExpediaAdapter{})
+	r.Register(JalanAdapter{})
+	r.Register(FallbackAdapter{})
+	return r
+}
+
+// Register はアダプタをRegistryに追加します。
+func (r *Registry) Register(a Adapter) {
+	r.adapters = append(r.adapters, a)
+}
+
+// Match はurlに最初に合致するアダプタを返します。合致するものがなければnilを返します。
+func (r *Registry) Match(url string) Adapter {
+	for _, a := range r.adapters {
+		if a.Matches(url) {
+			return a
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package site
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRegistry_Match(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	cases := []struct {
+		url      string
+		wantType Adapter
+	}{
+		{"https://travel.rakuten.co.jp/HOTEL/1/", RakutenAdapter{}},
+		{"https://www.booking.com/hotel/jp/1.html", BookingAdapter{}},
+		{"https://www.agoda.com/ja-jp/hotel1", AgodaAdapter{}},
+		{"https://www.expedia.co.jp/hotel1", ExpediaAdapter{}},
+		{"https://www.jalan.net/yad1/", JalanAdapter{}},
+		{"https://example.com/unknown-hotel", FallbackAdapter{}},
+	}
+
+	for _, c := range cases {
+		got := r.Match(c.url)
+		gotType := fmt.Sprintf("%T", got)
+		wantType := fmt.Sprintf("%T", c.wantType)
+		if gotType != wantType {
+			t.Errorf("Match(%q) = %s, want %s", c.url, gotType, wantType)
+		}
+	}
+}
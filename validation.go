@@ -0,0 +1,99 @@
+package main
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/meso1007/GO-HOTEL-ALERT-PROJECT-Backend/httpx"
+)
+
+// emailPattern はごく簡易なメールアドレス形式のチェックです(厳密なRFC準拠は目的としません)。
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// allowedHotelHosts はアラート対象として許可するOTA(予約サイト)のホスト名です。
+// site.NewDefaultRegistry()が対応しているサイトに合わせています。
+var allowedHotelHosts = []string{
+	"travel.rakuten.co.jp",
+	"booking.com",
+	"agoda.com",
+	"expedia.co.jp",
+	"expedia.com",
+	"jalan.net",
+}
+
+// isValidEmail はemailが簡易的な形式チェックを満たすかどうかを返します。
+func isValidEmail(email string) bool {
+	return emailPattern.MatchString(email)
+}
+
+// isAllowedHotelHost はrawURLのホストが許可されたOTAのものかどうかを返します。
+func isAllowedHotelHost(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range allowedHotelHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCreateAlertRequest はCreateAlertRequestの各フィールドを検証します。
+// 問題がなければ空のhttpx.Errorsを返します。
+func validateCreateAlertRequest(req CreateAlertRequest) httpx.Errors {
+	errs := httpx.Errors{}
+
+	if req.HotelURL == "" {
+		errs["hotelUrl"] = "hotelUrl is required"
+	} else if !isAllowedHotelHost(req.HotelURL) {
+		errs["hotelUrl"] = "hotelUrl must be a supported OTA (Rakuten Travel, Booking.com, Agoda, Expedia, or Jalan)"
+	}
+
+	if req.TargetPrice <= 0 {
+		errs["targetPrice"] = "targetPrice must be greater than 0"
+	}
+
+	if req.AlertMode != "" {
+		if err := validateAlertMode(req.AlertMode, req.ModeParams); err != nil {
+			errs["alertMode"] = err.Error()
+		}
+	}
+
+	return errs
+}
+
+// validateRegisterRequest はRegisterRequestの各フィールドを検証します。
+func validateRegisterRequest(req RegisterRequest) httpx.Errors {
+	errs := httpx.Errors{}
+
+	if req.Email == "" {
+		errs["email"] = "email is required"
+	} else if !isValidEmail(req.Email) {
+		errs["email"] = "email must be a valid email address"
+	}
+
+	if len(req.Password) < 8 {
+		errs["password"] = "password must be at least 8 characters"
+	}
+
+	return errs
+}
+
+// validateLoginRequest はLoginRequestの各フィールドを検証します。
+func validateLoginRequest(req LoginRequest) httpx.Errors {
+	errs := httpx.Errors{}
+
+	if req.Email == "" {
+		errs["email"] = "email is required"
+	}
+	if req.Password == "" {
+		errs["password"] = "password is required"
+	}
+
+	return errs
+}
@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestIsAllowedHotelHost(t *testing.T) {
+	allowed := []string{
+		"https://travel.rakuten.co.jp/HOTEL/12345/",
+		"https://www.booking.com/hotel/jp/example.html",
+		"https://www.agoda.com/example-hotel/hotel/tokyo-jp.html",
+		"https://www.jalan.net/yad12345/",
+	}
+	for _, url := range allowed {
+		if !isAllowedHotelHost(url) {
+			t.Errorf("isAllowedHotelHost(%q) = false, want true", url)
+		}
+	}
+
+	if isAllowedHotelHost("https://example.com/not-an-ota") {
+		t.Error("isAllowedHotelHost should reject hosts outside the allow-list")
+	}
+}
+
+func TestValidateCreateAlertRequest(t *testing.T) {
+	valid := CreateAlertRequest{
+		HotelURL:    "https://travel.rakuten.co.jp/HOTEL/12345/",
+		TargetPrice: 10000,
+		AlertMode:   AlertModeAbsolute,
+	}
+	if errs := validateCreateAlertRequest(valid); len(errs) != 0 {
+		t.Errorf("validateCreateAlertRequest(valid) = %v, want no errors", errs)
+	}
+
+	invalid := CreateAlertRequest{
+		HotelURL:    "https://example.com/not-an-ota",
+		TargetPrice: 0,
+	}
+	errs := validateCreateAlertRequest(invalid)
+	if _, ok := errs["hotelUrl"]; !ok {
+		t.Error("expected a hotelUrl error for a non-OTA host")
+	}
+	if _, ok := errs["targetPrice"]; !ok {
+		t.Error("expected a targetPrice error for a non-positive price")
+	}
+}